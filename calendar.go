@@ -0,0 +1,126 @@
+package timex
+
+// Calendar is a calendar system which numbers days with year, month, and day, so that dates can be
+// created, formatted and parsed in it. The months are numbered from 1 and the days of month are numbered from 1.
+//
+// The ordinal of a date is days since January 1 of year 1 in Gregorian calendar, which is the Rata Die minus 1.
+//
+// A calendar may implement the method MonthShortName(year, month int) string to give the abbreviated month names,
+// otherwise the full month names are used as the abbreviated names.
+type Calendar interface {
+	// FromOrdinal returns the year, month, and day of the ordinal in the calendar.
+	FromOrdinal(n int) (year, month, day int)
+	// ToOrdinal returns the ordinal of the year, month, and day in the calendar, which are valid.
+	ToOrdinal(year, month, day int) int
+	// MonthsInYear returns the number of months in the year.
+	MonthsInYear(year int) int
+	// DaysInMonth returns the number of days in the month of the year.
+	DaysInMonth(year, month int) int
+	// MonthName returns the full name of the month in the year.
+	MonthName(year, month int) string
+}
+
+type monthShortNamer interface {
+	MonthShortName(year, month int) string
+}
+
+// GregorianCalendar is the proleptic Gregorian calendar, which is the calendar of Date.
+type GregorianCalendar struct{}
+
+// FromOrdinal returns the year, month, and day of the ordinal in the calendar.
+func (c GregorianCalendar) FromOrdinal(n int) (year, month, day int) {
+	return ordinalToCalendar(n)
+}
+
+// ToOrdinal returns the ordinal of the year, month, and day in the calendar.
+func (c GregorianCalendar) ToOrdinal(year, month, day int) int {
+	return calendarToOrdinal(year, month, day)
+}
+
+// MonthsInYear returns the number of months in the year, which is 12.
+func (c GregorianCalendar) MonthsInYear(year int) int {
+	return 12
+}
+
+// DaysInMonth returns the number of days in the month of the year.
+func (c GregorianCalendar) DaysInMonth(year, month int) int {
+	return daysInMonth(year, month)
+}
+
+// MonthName returns the full English name of the month, such as January.
+func (c GregorianCalendar) MonthName(year, month int) string {
+	return monthLongNames[month-1]
+}
+
+// MonthShortName returns the abbreviated English name of the month, such as Jan.
+func (c GregorianCalendar) MonthShortName(year, month int) string {
+	return monthShortNames[month-1]
+}
+
+// In returns the year, month, and day specified by d in the calendar, nil means Gregorian calendar.
+func (d Date) In(calendar Calendar) (year, month, day int) {
+	if calendar == nil {
+		return ordinalToCalendar(d.ordinal)
+	}
+	return calendar.FromOrdinal(d.ordinal)
+}
+
+// DateIn returns the date corresponding to year, month, and day in the calendar, nil means Gregorian calendar.
+func DateIn(calendar Calendar, year, month, day int) (Date, error) {
+	if calendar == nil {
+		return NewDate(year, month, day)
+	}
+
+	if months := calendar.MonthsInYear(year); month < 1 || month > months {
+		return Date{}, rangeError(KindMonthOutOfRange, month, 1, months)
+	}
+
+	if days := calendar.DaysInMonth(year, month); day < 1 || day > days {
+		return Date{}, rangeError(KindDayOutOfRange, day, 1, days)
+	}
+
+	return Date{ordinal: calendar.ToOrdinal(year, month, day)}, nil
+}
+
+// MustDateIn is like DateIn but panics if the date cannot be created.
+func MustDateIn(calendar Calendar, year, month, day int) Date {
+	date, err := DateIn(calendar, year, month, day)
+	if err != nil {
+		panic(`timex: DateIn: ` + err.Error())
+	}
+	return date
+}
+
+// calendarNames returns the names of months in the calendar.
+func calendarNames(calendar Calendar) dateNames {
+	if calendar == nil {
+		return gregorianMonthNames
+	}
+	return dateNames{calendar: calendar}
+}
+
+// FormatIn returns a textual representation of the date d in the calendar, nil means Gregorian calendar.
+// The layout uses the tokens of Date.Format except the ISO 8601 week tokens,
+// and the month names are given by the calendar.
+func (d Date) FormatIn(calendar Calendar, layout string) string {
+	var f dateFields
+	f.year, f.month, f.day = d.In(calendar)
+	bytes := make([]byte, 0, len(layout)+10)
+	bytes = appendDate(bytes, layout, elemYear|elemMonth|elemDay, calendarNames(calendar), f)
+	return string(bytes)
+}
+
+// ParseDateIn parses a formatted string and returns the date it represents in the calendar,
+// nil means Gregorian calendar. The layout uses the tokens of ParseDate except the ISO 8601 week tokens,
+// and the month names are given by the calendar.
+//
+// The month names are those of the year parsed before them, so the year should precede the month name
+// in the layout if the month names vary by year.
+func ParseDateIn(calendar Calendar, layout, value string) (Date, error) {
+	f, err := parseDate(layout, value, elemYear|elemMonth|elemDay, calendarNames(calendar))
+	if err != nil {
+		return Date{}, err
+	}
+	date, err := DateIn(calendar, f.year, f.month, f.day)
+	return date, f.parseError(err)
+}
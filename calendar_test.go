@@ -0,0 +1,131 @@
+package timex_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/invzhi/timex"
+)
+
+var (
+	_ timex.Calendar = timex.GregorianCalendar{}
+	_ timex.Calendar = timex.TabularHijriCalendar{}
+	_ timex.Calendar = timex.UmmAlQuraCalendar{}
+	_ timex.Calendar = timex.HebrewCalendar{}
+	_ timex.Calendar = timex.PersianCalendar{}
+	_ timex.Calendar = timex.EthiopianCalendar{}
+	_ timex.Calendar = timex.CopticCalendar{}
+)
+
+// julianCalendar is a calendar implemented outside the package.
+type julianCalendar struct{}
+
+func (c julianCalendar) FromOrdinal(n int) (year, month, day int) {
+	return timex.DateFromRataDie(n + 1).JulianCalendar()
+}
+
+func (c julianCalendar) ToOrdinal(year, month, day int) int {
+	return timex.MustDateFromJulianCalendar(year, month, day).RataDie() - 1
+}
+
+func (c julianCalendar) MonthsInYear(year int) int {
+	return 12
+}
+
+func (c julianCalendar) DaysInMonth(year, month int) int {
+	if month == 2 && year%4 == 0 {
+		return 29
+	}
+	return timex.GregorianCalendar{}.DaysInMonth(2001, month)
+}
+
+func (c julianCalendar) MonthName(year, month int) string {
+	return []string{"Ianuarius", "Februarius", "Martius", "Aprilis", "Maius", "Iunius",
+		"Iulius", "Augustus", "September", "October", "November", "December"}[month-1]
+}
+
+func TestDateIn(t *testing.T) {
+	tests := []struct {
+		calendar         timex.Calendar
+		year, month, day int
+		date             timex.Date
+	}{
+		{nil, 2024, 3, 5, timex.MustNewDate(2024, 3, 5)},
+		{timex.GregorianCalendar{}, 2024, 2, 29, timex.MustNewDate(2024, 2, 29)},
+		{timex.GregorianCalendar{}, 1, 1, 1, timex.Date{}},
+		{julianCalendar{}, 1900, 2, 29, timex.MustNewDate(1900, 3, 13)},
+		{timex.HebrewCalendar{}, 5784, 13, 14, timex.MustNewDate(2024, 3, 24)},
+		{timex.TabularHijriCalendar{}, 1445, 9, 1, timex.MustNewDate(2024, 3, 11)},
+		{timex.UmmAlQuraCalendar{}, 1445, 9, 1, timex.MustNewDate(2024, 3, 11)},
+		{timex.PersianCalendar{}, 1403, 1, 1, timex.MustNewDate(2024, 3, 20)},
+		{timex.EthiopianCalendar{}, 2016, 13, 5, timex.MustNewDate(2024, 9, 10)},
+		{timex.CopticCalendar{}, 1741, 1, 1, timex.MustNewDate(2024, 9, 11)},
+	}
+
+	for _, tt := range tests {
+		date, err := timex.DateIn(tt.calendar, tt.year, tt.month, tt.day)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.date, date)
+
+		year, month, day := tt.date.In(tt.calendar)
+		assert.Equal(t, tt.year, year)
+		assert.Equal(t, tt.month, month)
+		assert.Equal(t, tt.day, day)
+	}
+
+	errTests := []struct {
+		calendar         timex.Calendar
+		year, month, day int
+		err              string
+	}{
+		{nil, 2023, 2, 29, "day is out of range [1,28]"},
+		{timex.GregorianCalendar{}, 2024, 13, 1, "month is out of range [1,12]"},
+		{julianCalendar{}, 1900, 2, 30, "day is out of range [1,29]"},
+		{timex.HebrewCalendar{}, 5785, 13, 1, "month is out of range [1,12]"},
+		{timex.EthiopianCalendar{}, 2016, 13, 6, "day is out of range [1,5]"},
+	}
+
+	for _, tt := range errTests {
+		_, err := timex.DateIn(tt.calendar, tt.year, tt.month, tt.day)
+		assert.EqualError(t, err, tt.err)
+	}
+
+	assert.PanicsWithValue(t, "timex: DateIn: month is out of range [1,13]", func() {
+		timex.MustDateIn(timex.CopticCalendar{}, 1741, 14, 1)
+	})
+}
+
+func TestDateFormatIn(t *testing.T) {
+	tests := []struct {
+		calendar timex.Calendar
+		layout   string
+		date     timex.Date
+		value    string
+	}{
+		{nil, "MMM D, YYYY", timex.MustNewDate(2024, 3, 5), "Mar 5, 2024"},
+		{timex.GregorianCalendar{}, "MMMM D, YYYY", timex.MustNewDate(2024, 3, 5), "March 5, 2024"},
+		{timex.GregorianCalendar{}, "MMM D, YYYY", timex.MustNewDate(2024, 3, 5), "Mar 5, 2024"},
+		{julianCalendar{}, "D MMMM YYYY", timex.MustNewDate(2024, 3, 5), "21 Februarius 2024"},
+		{julianCalendar{}, "D MMM YYYY", timex.MustNewDate(2024, 3, 5), "21 Februarius 2024"},
+		{timex.HebrewCalendar{}, "YYYY MMMM D", timex.MustNewDate(2024, 2, 29), "5784 Adar I 20"},
+		{timex.HebrewCalendar{}, "YYYY MMMM D", timex.MustNewDate(2025, 3, 14), "5785 Adar 14"},
+		{timex.TabularHijriCalendar{}, "D MMM YYYY", timex.MustNewDate(2024, 3, 11), "1 Ram. 1445"},
+		{timex.PersianCalendar{MonthNames: timex.PersianMonthNamesPersian}, "D MMMM YYYY", timex.MustNewDate(2024, 3, 20), "1 فروردین 1403"},
+		{timex.EthiopianCalendar{}, "MMMM D, YYYY", timex.MustNewDate(2024, 9, 10), "Pagume 5, 2016"},
+		{timex.CopticCalendar{}, "YYYY-MM-DD", timex.MustNewDate(2024, 9, 11), "1741-01-01"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.value, tt.date.FormatIn(tt.calendar, tt.layout))
+
+		date, err := timex.ParseDateIn(tt.calendar, tt.layout, tt.value)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.date, date)
+	}
+
+	_, err := timex.ParseDateIn(timex.HebrewCalendar{}, "YYYY MMMM D", "5785 Adar I 20")
+	assert.EqualError(t, err, `parsing "5785 Adar I 20" as "YYYY MMMM D": cannot parse "I 20" as "D"`)
+	_, err = timex.ParseDateIn(julianCalendar{}, "YYYY-MM-DD", "2023-02-29")
+	assert.EqualError(t, err, `parsing "2023-02-29" as "YYYY-MM-DD": day is out of range [1,28]`)
+}
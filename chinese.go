@@ -0,0 +1,530 @@
+package timex
+
+import (
+	"errors"
+	"fmt"
+	"math/bits"
+)
+
+//go:generate go run chinese_gen.go
+
+var chineseMonthNames = []string{
+	"正月",
+	"二月",
+	"三月",
+	"四月",
+	"五月",
+	"六月",
+	"七月",
+	"八月",
+	"九月",
+	"十月",
+	"冬月",
+	"腊月",
+}
+
+var chineseMonthTraditionalNames = []string{
+	"正月",
+	"二月",
+	"三月",
+	"四月",
+	"五月",
+	"六月",
+	"七月",
+	"八月",
+	"九月",
+	"十月",
+	"冬月",
+	"臘月",
+}
+
+const (
+	chineseMinYear = 1900
+	chineseMaxYear = 2100
+)
+
+// ChineseCalendar is the Chinese lunisolar calendar, also known as the agricultural calendar.
+// A month begins on the day of new moon and has 29 or 30 days, a year has 12 months,
+// or 13 months with a leap month which repeats the number of the previous month.
+//
+// It is supported from year 1900 to 2100 (January 31, 1900 to January 28, 2101) by an embedded table,
+// which is computed with the modern rules in UTC+8. The calendars published before 1929 used the local time of Beijing,
+// and differ from the table in a few months of 1906, 1914, 1915, 1916 and 1920.
+//
+// The months of a year are numbered from 1 in order including the leap month as the other calendars,
+// Month and MonthNumber convert them from and to the month numbers such as 4 of 闰四月.
+type ChineseCalendar struct {
+	// Traditional reports whether the month names are formatted in traditional Chinese, otherwise simplified Chinese.
+	Traditional bool
+}
+
+func (c ChineseCalendar) names() (names []string, leap string) {
+	if c.Traditional {
+		return chineseMonthTraditionalNames, "閏"
+	}
+	return chineseMonthNames, "闰"
+}
+
+func chineseYear(year int) (uint32, error) {
+	if year < chineseMinYear || year > chineseMaxYear {
+		return 0, rangeError(KindYearOutOfRange, year, chineseMinYear, chineseMaxYear)
+	}
+	return chineseYears[year-chineseMinYear], nil
+}
+
+// chineseNewYear returns the ordinal of the first day of the year encoded in v.
+func chineseNewYear(year int, v uint32) int {
+	return calendarToOrdinal(year, 1, 1) + int(v>>17)
+}
+
+// chineseLeapMonth returns the leap month of the year encoded in v, or 0 if there is no leap month.
+func chineseLeapMonth(v uint32) int {
+	return int(v >> 13 & 0xf)
+}
+
+// chineseMonthIndex returns the index of the month in the year encoded in v, which counts the leap month.
+func chineseMonthIndex(v uint32, month int, leap bool) int {
+	if lm := chineseLeapMonth(v); leap || lm != 0 && month > lm {
+		return month
+	}
+	return month - 1
+}
+
+func chineseDaysInMonth(v uint32, index int) int {
+	return 29 + int(v>>index&1)
+}
+
+func chineseDaysInYear(v uint32) int {
+	months := 12
+	if chineseLeapMonth(v) != 0 {
+		months++
+	}
+	return months*29 + bits.OnesCount32(v&0x1fff)
+}
+
+// IsLeapYear reports whether the year has a leap month. It returns false if the year is out of range.
+func (c ChineseCalendar) IsLeapYear(year int) bool {
+	return c.LeapMonth(year) != 0
+}
+
+// LeapMonth returns the month number repeated by the leap month in the year, or 0 if there is no leap month.
+// It returns 0 if the year is out of range.
+func (c ChineseCalendar) LeapMonth(year int) int {
+	v, err := chineseYear(year)
+	if err != nil {
+		return 0
+	}
+	return chineseLeapMonth(v)
+}
+
+// MonthsInYear returns the number of months in the year, which is 13 if the year has a leap month, otherwise 12.
+// It returns 0 if the year is out of range.
+func (c ChineseCalendar) MonthsInYear(year int) int {
+	v, err := chineseYear(year)
+	switch {
+	case err != nil:
+		return 0
+	case chineseLeapMonth(v) != 0:
+		return 13
+	default:
+		return 12
+	}
+}
+
+// Month returns the month in the year of the month number, the month is the leap month if leap is true.
+// The months are numbered from 1 in order including the leap month, such as the leap month 闰二月 is month 3.
+func (c ChineseCalendar) Month(year, number int, leap bool) (int, error) {
+	v, err := chineseYear(year)
+	if err != nil {
+		return 0, err
+	}
+
+	if number < 1 || number > 12 {
+		return 0, rangeError(KindMonthOutOfRange, number, 1, 12)
+	}
+
+	if leap && number != chineseLeapMonth(v) {
+		return 0, fmt.Errorf("month %d is not a leap month", number)
+	}
+	return chineseMonthIndex(v, number, leap) + 1, nil
+}
+
+// MonthNumber returns the month number of the month in the year, the month is the leap month if leap is true.
+// It returns 0 if the month does not exist.
+func (c ChineseCalendar) MonthNumber(year, month int) (number int, leap bool) {
+	if month < 1 || month > c.MonthsInYear(year) {
+		return 0, false
+	}
+
+	if lm := c.LeapMonth(year); lm != 0 && month > lm {
+		return month - 1, month == lm+1
+	}
+	return month, false
+}
+
+// MonthName returns the Chinese name of the month in the year, such as 正月 and 闰四月.
+// It returns an empty string if the month does not exist.
+func (c ChineseCalendar) MonthName(year, month int) string {
+	number, leap := c.MonthNumber(year, month)
+	if number == 0 {
+		return ""
+	}
+
+	names, prefix := c.names()
+	if leap {
+		return prefix + names[number-1]
+	}
+	return names[number-1]
+}
+
+// DaysInMonth returns the number of days in the month of the year, or 0 if the month does not exist.
+func (c ChineseCalendar) DaysInMonth(year, month int) int {
+	v, err := chineseYear(year)
+	if err != nil || month < 1 || month > c.MonthsInYear(year) {
+		return 0
+	}
+	return chineseDaysInMonth(v, month-1)
+}
+
+// ToOrdinal returns the ordinal of the year, month, and day in the calendar, see Calendar for the ordinal.
+func (c ChineseCalendar) ToOrdinal(year, month, day int) int {
+	v, _ := chineseYear(year)
+	n := chineseNewYear(year, v) + day - 1
+	for i := 0; i < month-1; i++ {
+		n += chineseDaysInMonth(v, i)
+	}
+	return n
+}
+
+// FromOrdinal returns the year, month, and day of the ordinal in the calendar, see Calendar for the ordinal.
+// It returns zeros if the ordinal is out of range.
+func (c ChineseCalendar) FromOrdinal(n int) (year, month, day int) {
+	year, _ = ordinalToOrdinalDate(n)
+	if year > chineseMaxYear {
+		year = chineseMaxYear
+	}
+	v, err := chineseYear(year)
+	if err == nil && n < chineseNewYear(year, v) {
+		year--
+		v, err = chineseYear(year)
+	}
+	if err != nil || n >= chineseNewYear(year, v)+chineseDaysInYear(v) {
+		return 0, 0, 0
+	}
+
+	n -= chineseNewYear(year, v)
+	index := 0
+	for days := chineseDaysInMonth(v, index); n >= days; days = chineseDaysInMonth(v, index) {
+		n -= days
+		index++
+	}
+	return year, index + 1, n + 1
+}
+
+// NewDate returns the date corresponding to year, month, and day in the calendar.
+// The months are numbered in order including the leap month, see Month.
+func (c ChineseCalendar) NewDate(year, month, day int) (Date, error) {
+	if _, err := chineseYear(year); err != nil {
+		return Date{}, err
+	}
+
+	if months := c.MonthsInYear(year); month < 1 || month > months {
+		return Date{}, rangeError(KindMonthOutOfRange, month, 1, months)
+	}
+
+	if days := c.DaysInMonth(year, month); day < 1 || day > days {
+		return Date{}, rangeError(KindDayOutOfRange, day, 1, days)
+	}
+
+	return Date{ordinal: c.ToOrdinal(year, month, day)}, nil
+}
+
+// MustNewDate is like NewDate but panics if the date cannot be created.
+func (c ChineseCalendar) MustNewDate(year, month, day int) Date {
+	date, err := c.NewDate(year, month, day)
+	if err != nil {
+		panic(`timex: ChineseCalendar.NewDate: ` + err.Error())
+	}
+	return date
+}
+
+// Date returns the year, month, and day specified by d in the calendar.
+// The months are numbered in order including the leap month, see MonthNumber.
+// It returns zeros if the date is out of range.
+func (c ChineseCalendar) Date(d Date) (year, month, day int) {
+	return c.FromOrdinal(d.ordinal)
+}
+
+// Parse parses a formatted string and returns the date it represents in the calendar.
+// The layout uses the tokens of ParseDate, and the month names are the Chinese month names, such as 正月 and 闰四月.
+// The month names are those of the year parsed before them, and the months parsed from digits include the leap month.
+func (c ChineseCalendar) Parse(layout, value string) (Date, error) {
+	f, err := parseDate(layout, value, elemYear|elemMonth|elemDay, dateNames{calendar: c})
+	if err != nil {
+		return Date{}, err
+	}
+	date, err := c.NewDate(f.year, f.month, f.day)
+	return date, f.parseError(err)
+}
+
+// Format returns a textual representation of the date d in the calendar.
+// The layout uses the tokens of Date.Format, and the month names are the Chinese month names, such as 正月 and 闰四月.
+// The dates out of range are formatted as year, month and day 0.
+func (c ChineseCalendar) Format(d Date, layout string) string {
+	var f dateFields
+	f.year, f.month, f.day = c.Date(d)
+	bytes := make([]byte, 0, len(layout)+10)
+	bytes = appendDate(bytes, layout, elemYear|elemMonth|elemDay, dateNames{calendar: c}, f)
+	return string(bytes)
+}
+
+// SolarTerm is one of the 24 solar terms, which divide the ecliptic longitude of the sun into 15° each.
+type SolarTerm int
+
+const (
+	SolarTermMinorCold          SolarTerm = iota + 1 // Xiaohan, 285°, around January 6.
+	SolarTermMajorCold                               // Dahan, 300°, around January 20.
+	SolarTermStartOfSpring                           // Lichun, 315°, around February 4.
+	SolarTermRainWater                               // Yushui, 330°, around February 19.
+	SolarTermAwakeningOfInsects                      // Jingzhe, 345°, around March 6.
+	SolarTermSpringEquinox                           // Chunfen, 0°, around March 21.
+	SolarTermPureBrightness                          // Qingming, 15°, around April 5.
+	SolarTermGrainRain                               // Guyu, 30°, around April 20.
+	SolarTermStartOfSummer                           // Lixia, 45°, around May 6.
+	SolarTermGrainBuds                               // Xiaoman, 60°, around May 21.
+	SolarTermGrainInEar                              // Mangzhong, 75°, around June 6.
+	SolarTermSummerSolstice                          // Xiazhi, 90°, around June 21.
+	SolarTermMinorHeat                               // Xiaoshu, 105°, around July 7.
+	SolarTermMajorHeat                               // Dashu, 120°, around July 23.
+	SolarTermStartOfAutumn                           // Liqiu, 135°, around August 8.
+	SolarTermEndOfHeat                               // Chushu, 150°, around August 23.
+	SolarTermWhiteDew                                // Bailu, 165°, around September 8.
+	SolarTermAutumnEquinox                           // Qiufen, 180°, around September 23.
+	SolarTermColdDew                                 // Hanlu, 195°, around October 8.
+	SolarTermFrostsDescent                           // Shuangjiang, 210°, around October 23.
+	SolarTermStartOfWinter                           // Lidong, 225°, around November 7.
+	SolarTermMinorSnow                               // Xiaoxue, 240°, around November 22.
+	SolarTermMajorSnow                               // Daxue, 255°, around December 7.
+	SolarTermWinterSolstice                          // Dongzhi, 270°, around December 22.
+)
+
+var solarTermNames = []string{
+	"Minor Cold",
+	"Major Cold",
+	"Start of Spring",
+	"Rain Water",
+	"Awakening of Insects",
+	"Spring Equinox",
+	"Pure Brightness",
+	"Grain Rain",
+	"Start of Summer",
+	"Grain Buds",
+	"Grain in Ear",
+	"Summer Solstice",
+	"Minor Heat",
+	"Major Heat",
+	"Start of Autumn",
+	"End of Heat",
+	"White Dew",
+	"Autumn Equinox",
+	"Cold Dew",
+	"Frost's Descent",
+	"Start of Winter",
+	"Minor Snow",
+	"Major Snow",
+	"Winter Solstice",
+}
+
+// String returns the English name of the solar term.
+func (t SolarTerm) String() string {
+	if t < SolarTermMinorCold || t > SolarTermWinterSolstice {
+		return "%!SolarTerm(" + string(appendInt(nil, int(t), 0)) + ")"
+	}
+	return solarTermNames[t-1]
+}
+
+// SolarTerm returns the date of the solar term in the year of Gregorian calendar, in UTC+8.
+func (c ChineseCalendar) SolarTerm(year int, term SolarTerm) (Date, error) {
+	if year < chineseMinYear || year > chineseMaxYear {
+		return Date{}, rangeError(KindYearOutOfRange, year, chineseMinYear, chineseMaxYear)
+	}
+	if term < SolarTermMinorCold || term > SolarTermWinterSolstice {
+		return Date{}, errors.New("unknown solar term")
+	}
+
+	// There are two solar terms in each month.
+	day := chineseSolarTermDays[year-chineseMinYear][term-1]
+	return Date{ordinal: calendarToOrdinal(year, int(term+1)/2, int(day))}, nil
+}
+
+// SolarTermOn returns the solar term on the date d, it reports false if there is no solar term on the date
+// or the date is out of range.
+func (c ChineseCalendar) SolarTermOn(d Date) (SolarTerm, bool) {
+	year, month, day := ordinalToCalendar(d.ordinal)
+	if year < chineseMinYear || year > chineseMaxYear {
+		return 0, false
+	}
+
+	days := chineseSolarTermDays[year-chineseMinYear]
+	for term := month*2 - 1; term <= month*2; term++ {
+		if int(days[term-1]) == day {
+			return SolarTerm(term), true
+		}
+	}
+	return 0, false
+}
+
+var (
+	heavenlyStems   = []string{"甲", "乙", "丙", "丁", "戊", "己", "庚", "辛", "壬", "癸"}
+	earthlyBranches = []string{"子", "丑", "寅", "卯", "辰", "巳", "午", "未", "申", "酉", "戌", "亥"}
+	chineseZodiacs  = []string{"Rat", "Ox", "Tiger", "Rabbit", "Dragon", "Snake", "Horse", "Goat", "Monkey", "Rooster", "Dog", "Pig"}
+)
+
+// HeavenlyStem is one of the 10 Heavenly Stems, in range [0,9] from 甲 to 癸.
+type HeavenlyStem int
+
+// String returns the Chinese name of the Heavenly Stem.
+func (s HeavenlyStem) String() string {
+	if s < 0 || int(s) >= len(heavenlyStems) {
+		return "%!HeavenlyStem(" + string(appendInt(nil, int(s), 0)) + ")"
+	}
+	return heavenlyStems[s]
+}
+
+// EarthlyBranch is one of the 12 Earthly Branches, in range [0,11] from 子 to 亥.
+type EarthlyBranch int
+
+// String returns the Chinese name of the Earthly Branch.
+func (b EarthlyBranch) String() string {
+	if b < 0 || int(b) >= len(earthlyBranches) {
+		return "%!EarthlyBranch(" + string(appendInt(nil, int(b), 0)) + ")"
+	}
+	return earthlyBranches[b]
+}
+
+// Zodiac returns the zodiac animal of the Earthly Branch.
+func (b EarthlyBranch) Zodiac() ChineseZodiac {
+	return ChineseZodiac(b)
+}
+
+// ChineseZodiac is one of the 12 animals of Chinese zodiac, in range [0,11] from Rat to Pig.
+type ChineseZodiac int
+
+// String returns the English name of the zodiac animal.
+func (z ChineseZodiac) String() string {
+	if z < 0 || int(z) >= len(chineseZodiacs) {
+		return "%!ChineseZodiac(" + string(appendInt(nil, int(z), 0)) + ")"
+	}
+	return chineseZodiacs[z]
+}
+
+// Sexagenary is the sexagenary cycle of the Heavenly Stems and Earthly Branches, in range [0,59] from 甲子 to 癸亥.
+type Sexagenary int
+
+// Stem returns the Heavenly Stem of the sexagenary cycle.
+func (s Sexagenary) Stem() HeavenlyStem {
+	return HeavenlyStem(s % 10)
+}
+
+// Branch returns the Earthly Branch of the sexagenary cycle.
+func (s Sexagenary) Branch() EarthlyBranch {
+	return EarthlyBranch(s % 12)
+}
+
+// Zodiac returns the zodiac animal of the Earthly Branch.
+func (s Sexagenary) Zodiac() ChineseZodiac {
+	return s.Branch().Zodiac()
+}
+
+// String returns the Chinese name of the sexagenary cycle, such as 甲子.
+func (s Sexagenary) String() string {
+	if s < 0 || s >= 60 {
+		return "%!Sexagenary(" + string(appendInt(nil, int(s), 0)) + ")"
+	}
+	return s.Stem().String() + s.Branch().String()
+}
+
+// YearSexagenary returns the sexagenary cycle of the year in the calendar, year 4 is 甲子.
+func (c ChineseCalendar) YearSexagenary(year int) Sexagenary {
+	return Sexagenary(floorMod(year-4, 60))
+}
+
+// DaySexagenary returns the sexagenary cycle of the date d, October 1, 1949 is 甲子.
+func (c ChineseCalendar) DaySexagenary(d Date) Sexagenary {
+	return Sexagenary(floorMod(d.ordinal-711765, 60))
+}
+
+// ChineseHoliday is a traditional Chinese holiday.
+type ChineseHoliday int
+
+const (
+	ChineseHolidaySpringFestival  ChineseHoliday = iota + 1 // Month 1 day 1, the new year.
+	ChineseHolidayLanternFestival                           // Month 1 day 15.
+	ChineseHolidayQingming                                  // The solar term Pure Brightness.
+	ChineseHolidayDragonBoat                                // Month 5 day 5.
+	ChineseHolidayQixi                                      // Month 7 day 7.
+	ChineseHolidayMidAutumn                                 // Month 8 day 15.
+	ChineseHolidayDoubleNinth                               // Month 9 day 9.
+	ChineseHolidayDongzhi                                   // The solar term Winter Solstice.
+	ChineseHolidayNewYearsEve                               // The last day of the previous year, the day before Spring Festival.
+)
+
+var chineseHolidayNames = []string{
+	"Spring Festival",
+	"Lantern Festival",
+	"Qingming Festival",
+	"Dragon Boat Festival",
+	"Qixi Festival",
+	"Mid-Autumn Festival",
+	"Double Ninth Festival",
+	"Dongzhi Festival",
+	"New Year's Eve",
+}
+
+// String returns the English name of the holiday.
+func (h ChineseHoliday) String() string {
+	if h < ChineseHolidaySpringFestival || h > ChineseHolidayNewYearsEve {
+		return "%!ChineseHoliday(" + string(appendInt(nil, int(h), 0)) + ")"
+	}
+	return chineseHolidayNames[h-1]
+}
+
+// holidayDate returns the date of the day in the month number of the year, which is not the leap month.
+func (c ChineseCalendar) holidayDate(year, number, day int) (Date, error) {
+	month, err := c.Month(year, number, false)
+	if err != nil {
+		return Date{}, err
+	}
+	return c.NewDate(year, month, day)
+}
+
+// Holiday returns the date of the holiday in the year of Gregorian calendar.
+func (c ChineseCalendar) Holiday(year int, holiday ChineseHoliday) (Date, error) {
+	// Month 1 to 9 of Chinese year y are in Gregorian year y.
+	switch holiday {
+	case ChineseHolidaySpringFestival:
+		return c.holidayDate(year, 1, 1)
+	case ChineseHolidayLanternFestival:
+		return c.holidayDate(year, 1, 15)
+	case ChineseHolidayQingming:
+		return c.SolarTerm(year, SolarTermPureBrightness)
+	case ChineseHolidayDragonBoat:
+		return c.holidayDate(year, 5, 5)
+	case ChineseHolidayQixi:
+		return c.holidayDate(year, 7, 7)
+	case ChineseHolidayMidAutumn:
+		return c.holidayDate(year, 8, 15)
+	case ChineseHolidayDoubleNinth:
+		return c.holidayDate(year, 9, 9)
+	case ChineseHolidayDongzhi:
+		return c.SolarTerm(year, SolarTermWinterSolstice)
+	case ChineseHolidayNewYearsEve:
+		date, err := c.holidayDate(year, 1, 1)
+		if err != nil {
+			return Date{}, err
+		}
+		return date.AddDays(-1), nil
+	default:
+		return Date{}, errors.New("unknown holiday")
+	}
+}
@@ -0,0 +1,364 @@
+//go:build ignore
+
+// This program generates chinese_table.go, the months of Chinese calendar and the days of solar terms.
+// The new moons and solar terms are computed in UTC+8 with the algorithms in Astronomical Algorithms by Jean Meeus,
+// the longitude of the sun uses the abridged VSOP87 series, and ΔT uses the polynomials of Espenak and Meeus.
+//
+// Run with:
+//
+//	go run chinese_gen.go
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"math"
+	"os"
+)
+
+const (
+	minYear = 1900
+	maxYear = 2100
+	rad     = math.Pi / 180
+	offset  = 8.0 / 24 // UTC+8, the standard time of China.
+)
+
+func main() {
+	var b bytes.Buffer
+	b.WriteString("// Code generated by chinese_gen.go; DO NOT EDIT.\n\npackage timex\n\n")
+
+	fmt.Fprintf(&b, "// chineseYears is the years %d to %d of Chinese calendar.\n", minYear, maxYear)
+	b.WriteString("// The bit i is set if the (i+1)-th month in the year including the leap month has 30 days, in bits 0 to 12,\n")
+	b.WriteString("// the leap month or 0 is in bits 13 to 16, and the days from January 1 to the new year are in bits 17 to 22.\n")
+	b.WriteString("var chineseYears = [...]uint32{\n")
+	years := lunarYears()
+	for i := 0; i < len(years); i += 10 {
+		b.WriteString("\t")
+		for j := i; j < i+10 && j < len(years); j++ {
+			fmt.Fprintf(&b, "0x%06x, ", years[j])
+		}
+		fmt.Fprintf(&b, "// %d\n", minYear+i)
+	}
+	b.WriteString("}\n\n")
+
+	fmt.Fprintf(&b, "// chineseSolarTermDays is the days of month of the 24 solar terms from %d to %d, beginning at Minor Cold.\n", minYear, maxYear)
+	b.WriteString("var chineseSolarTermDays = [...][24]uint8{\n")
+	for year := minYear; year <= maxYear; year++ {
+		b.WriteString("\t{")
+		for term := 0; term < 24; term++ {
+			_, _, day := jdnToDate(solarTermDay(year, term))
+			if term > 0 {
+				b.WriteString(", ")
+			}
+			fmt.Fprintf(&b, "%d", day)
+		}
+		fmt.Fprintf(&b, "}, // %d\n", year)
+	}
+	b.WriteString("}\n")
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("chinese_table.go", src, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+type month struct {
+	year, number int
+	leap         bool
+	start        int // Julian day number of the first day.
+}
+
+// lunarYears returns the encoded years of Chinese calendar.
+func lunarYears() []uint32 {
+	var months []month
+	// Every span from the month of winter solstice to the next one has 12 or 13 months.
+	for year := minYear; year <= maxYear+1; year++ {
+		m11 := newMoonDayOnOrBefore(solarTermDay(year-1, 23))
+		next := newMoonDayOnOrBefore(solarTermDay(year, 23))
+
+		var starts []int
+		for day := m11; day < next; day = nextNewMoonDay(day) {
+			starts = append(starts, day)
+		}
+		starts = append(starts, next)
+
+		// In a span of 13 months, the first month without principal term is the leap month.
+		leap := -1
+		if len(starts) == 14 {
+			for i := 0; i < 13 && leap < 0; i++ {
+				if !hasPrincipalTerm(starts[i], starts[i+1]) {
+					leap = i
+				}
+			}
+		}
+
+		number, lunarYear := 11, year-1
+		for i, start := range starts[:len(starts)-1] {
+			if i > 0 && i != leap {
+				number++
+				if number == 13 {
+					number, lunarYear = 1, year
+				}
+			}
+			months = append(months, month{year: lunarYear, number: number, leap: i == leap, start: start})
+		}
+	}
+	months = append(months, month{start: newMoonDayOnOrBefore(solarTermDay(maxYear+1, 23))})
+
+	var years []uint32
+	var index int // The index of month in the year.
+	for i, m := range months[:len(months)-1] {
+		if m.year < minYear || m.year > maxYear {
+			continue
+		}
+		if m.number == 1 && !m.leap {
+			years = append(years, uint32(m.start-dateToJDN(m.year, 1, 1))<<17)
+			index = 0
+		}
+		if m.leap {
+			years[len(years)-1] |= uint32(m.number) << 13
+		}
+		if months[i+1].start-m.start == 30 {
+			years[len(years)-1] |= 1 << index
+		}
+		index++
+	}
+	return years
+}
+
+// hasPrincipalTerm reports whether there is a principal term, which longitude of the sun is a multiple of 30°,
+// in the days [start,end).
+func hasPrincipalTerm(start, end int) bool {
+	for lon := 0.0; lon < 360; lon += 30 {
+		day := localDay(solarTermJDE(lon, float64(start)))
+		if day >= start && day < end {
+			return true
+		}
+	}
+	return false
+}
+
+// solarTermDay returns the Julian day number of the solar term in the year, term 0 is Minor Cold at 285°.
+func solarTermDay(year, term int) int {
+	lon := math.Mod(285+15*float64(term), 360)
+	return localDay(solarTermJDE(lon, float64(dateToJDN(year, 1, 6))+15.2*float64(term)))
+}
+
+func newMoonDayOnOrBefore(day int) int {
+	k := math.Floor((float64(day)-2451550.09766)/29.530588861) + 1
+	for localDay(newMoonJDE(k)) > day {
+		k--
+	}
+	return localDay(newMoonJDE(k))
+}
+
+func nextNewMoonDay(day int) int {
+	k := math.Floor((float64(day)-2451550.09766)/29.530588861) - 1
+	for localDay(newMoonJDE(k)) <= day {
+		k++
+	}
+	return localDay(newMoonJDE(k))
+}
+
+func jdnToDate(jdn int) (year, month, day int) {
+	a := jdn + 32044
+	b := (4*a + 3) / 146097
+	c := a - 146097*b/4
+	d := (4*c + 3) / 1461
+	e := c - 1461*d/4
+	m := (5*e + 2) / 153
+	return 100*b + d - 4800 + m/10, m + 3 - 12*(m/10), e - (153*m+2)/5 + 1
+}
+
+func dateToJDN(year, month, day int) int {
+	a := (14 - month) / 12
+	y := year + 4800 - a
+	m := month + 12*a - 3
+	return day + (153*m+2)/5 + 365*y + y/4 - y/100 + y/400 - 32045
+}
+
+// localDay returns the Julian day number of the date in UTC+8 at the Julian ephemeris day.
+func localDay(jde float64) int {
+	year := 2000 + (jde-2451545)/365.25
+	return int(math.Floor(jde - deltaT(year)/86400 + offset + 0.5))
+}
+
+// Abridged VSOP87D series of Earth heliocentric longitude (Meeus, Astronomical Algorithms, appendix III).
+var earthL = [][][3]float64{
+	{
+		{175347046, 0, 0}, {3341656, 4.6692568, 6283.07585}, {34894, 4.6261, 12566.1517}, {3497, 2.7441, 5753.3849},
+		{3418, 2.8289, 3.5231}, {3136, 3.6277, 77713.7715}, {2676, 4.4181, 7860.4194}, {2343, 6.1352, 3930.2097},
+		{1324, 0.7425, 11506.7698}, {1273, 2.0371, 529.691}, {1199, 1.1096, 1577.3435}, {990, 5.233, 5884.927},
+		{902, 2.045, 26.298}, {857, 3.508, 398.149}, {780, 1.179, 5223.694}, {753, 2.533, 5507.553},
+		{505, 4.583, 18849.228}, {492, 4.205, 775.523}, {357, 2.92, 0.067}, {317, 5.849, 11790.629},
+		{284, 1.899, 796.298}, {271, 0.315, 10977.079}, {243, 0.345, 5486.778}, {206, 4.806, 2544.314},
+		{205, 1.869, 5573.143}, {202, 2.458, 6069.777}, {156, 0.833, 213.299}, {132, 3.411, 2942.463},
+		{126, 1.083, 20.775}, {115, 0.645, 0.98}, {103, 0.636, 4694.003}, {102, 0.976, 15720.839},
+		{102, 4.267, 7.114}, {99, 6.21, 2146.17}, {98, 0.68, 155.42}, {86, 5.98, 161000.69},
+		{85, 1.3, 6275.96}, {85, 3.67, 71430.7}, {80, 1.81, 17260.15}, {79, 3.04, 12036.46},
+		{75, 1.76, 5088.63}, {74, 3.5, 3154.69}, {74, 4.68, 801.82}, {70, 0.83, 9437.76},
+		{62, 3.98, 8827.39}, {61, 1.82, 7084.9}, {57, 2.78, 6286.6}, {56, 4.39, 14143.5},
+		{56, 3.47, 6279.55}, {52, 0.19, 12139.55}, {52, 1.33, 1748.02}, {51, 0.28, 5856.48},
+		{49, 0.49, 1194.45}, {41, 5.37, 8429.24}, {41, 2.4, 19651.05}, {39, 6.17, 10447.39},
+		{37, 6.04, 10213.29}, {37, 2.57, 1059.38}, {36, 1.71, 2352.87}, {36, 1.78, 6812.77},
+		{33, 0.59, 17789.85}, {30, 0.44, 83996.85}, {30, 2.74, 1349.87}, {25, 3.16, 4690.48},
+	},
+	{
+		{628331966747, 0, 0}, {206059, 2.678235, 6283.07585}, {4303, 2.6351, 12566.1517}, {425, 1.59, 3.523},
+		{119, 5.796, 26.298}, {109, 2.966, 1577.344}, {93, 2.59, 18849.23}, {72, 1.14, 529.69},
+		{68, 1.87, 398.15}, {67, 4.41, 5507.55}, {59, 2.89, 5223.69}, {56, 2.17, 155.42},
+		{45, 0.4, 796.3}, {36, 0.47, 775.52}, {29, 2.65, 7.11}, {21, 5.34, 0.98},
+		{19, 1.85, 5486.78}, {19, 4.97, 213.3}, {17, 2.99, 6275.96}, {16, 0.03, 2544.31},
+		{16, 1.43, 2146.17}, {15, 1.21, 10977.08}, {12, 2.83, 1748.02}, {12, 3.26, 5088.63},
+		{12, 5.27, 1194.45}, {12, 2.08, 4694}, {11, 0.77, 553.57}, {10, 1.3, 6286.6},
+		{10, 4.24, 1349.87}, {9, 2.7, 242.73}, {9, 5.64, 951.72}, {8, 5.3, 2352.87},
+		{6, 2.65, 9437.76}, {6, 4.67, 4690.48},
+	},
+	{
+		{52919, 0, 0}, {8720, 1.0721, 6283.0758}, {309, 0.867, 12566.152}, {27, 0.05, 3.52},
+		{16, 5.19, 26.3}, {16, 3.68, 155.42}, {10, 0.76, 18849.23}, {9, 2.06, 77713.77},
+		{7, 0.83, 775.52}, {5, 4.66, 1577.34}, {4, 1.03, 7.11}, {4, 3.44, 5573.14},
+		{3, 5.14, 796.3}, {3, 6.05, 5507.55}, {3, 1.19, 242.73}, {3, 6.12, 529.69},
+		{3, 0.31, 398.15}, {3, 2.28, 553.57}, {2, 4.38, 5223.69}, {2, 3.75, 0.98},
+	},
+	{
+		{289, 5.844, 6283.076}, {35, 0, 0}, {17, 5.49, 12566.15}, {3, 5.2, 155.42},
+		{1, 4.72, 3.52}, {1, 5.3, 18849.23}, {1, 5.97, 242.73},
+	},
+	{{114, 3.142, 0}, {8, 4.13, 6283.08}, {1, 3.84, 12566.15}},
+	{{1, 3.14, 0}},
+}
+
+// Earth latitude B0, B1 (for FK5 correction not needed) and radius R0 first terms for aberration.
+var earthR0 = [][3]float64{
+	{100013989, 0, 0}, {1670700, 3.0984635, 6283.07585}, {13956, 3.05525, 12566.1517}, {3084, 5.1985, 77713.7715},
+	{1628, 1.1739, 5753.3849}, {1576, 2.8469, 7860.4194}, {925, 5.453, 11506.77}, {542, 4.564, 3930.21},
+	{472, 3.661, 5884.927}, {346, 0.964, 5507.553}, {329, 5.9, 5223.694}, {307, 0.299, 5573.143},
+}
+
+func norm360(x float64) float64 {
+	x = math.Mod(x, 360)
+	if x < 0 {
+		x += 360
+	}
+	return x
+}
+
+// sunLongitude returns the apparent geocentric longitude of the sun in degrees at JDE (TT).
+func sunLongitude(jde float64) float64 {
+	tau := (jde - 2451545) / 365250
+	var L float64
+	tp := 1.0
+	for _, series := range earthL {
+		var s float64
+		for _, t := range series {
+			s += t[0] * math.Cos(t[1]+t[2]*tau)
+		}
+		L += s * tp
+		tp *= tau
+	}
+	L /= 1e8
+	var R float64
+	for _, t := range earthR0 {
+		R += t[0] * math.Cos(t[1]+t[2]*tau)
+	}
+	R /= 1e8
+
+	theta := norm360(L/rad + 180)
+	T := 10 * tau
+	// FK5 correction.
+	theta -= 0.09033 / 3600
+	// Nutation in longitude.
+	omega := (125.04452 - 1934.136261*T) * rad
+	Ls := (280.4665 + 36000.7698*T) * rad
+	Lm := (218.3165 + 481267.8813*T) * rad
+	dpsi := -17.20*math.Sin(omega) - 1.32*math.Sin(2*Ls) - 0.23*math.Sin(2*Lm) + 0.21*math.Sin(2*omega)
+	theta += dpsi / 3600
+	// Aberration.
+	theta -= 20.4898 / 3600 / R
+	return norm360(theta)
+}
+
+// solarTermJDE returns the JDE when the apparent longitude of the sun is lon, near jde0.
+func solarTermJDE(lon, jde0 float64) float64 {
+	jde := jde0
+	for i := 0; i < 50; i++ {
+		d := lon - sunLongitude(jde)
+		d = math.Mod(d+540, 360) - 180
+		if math.Abs(d) < 1e-9 {
+			break
+		}
+		jde += d * 365.2422 / 360
+	}
+	return jde
+}
+
+// newMoonJDE returns the JDE of new moon of lunation k (Meeus, chapter 49).
+func newMoonJDE(k float64) float64 {
+	T := k / 1236.85
+	T2, T3, T4 := T*T, T*T*T, T*T*T*T
+	jde := 2451550.09766 + 29.530588861*k + 0.00015437*T2 - 0.000000150*T3 + 0.00000000073*T4
+	E := 1 - 0.002516*T - 0.0000074*T2
+	M := (2.5534 + 29.10535670*k - 0.0000014*T2 - 0.00000011*T3) * rad
+	Mp := (201.5643 + 385.81693528*k + 0.0107582*T2 + 0.00001238*T3 - 0.000000058*T4) * rad
+	F := (160.7108 + 390.67050284*k - 0.0016118*T2 - 0.00000227*T3 + 0.000000011*T4) * rad
+	O := (124.7746 - 1.56375588*k + 0.0020672*T2 + 0.00000215*T3) * rad
+	s := math.Sin
+	c := -0.40720*s(Mp) + 0.17241*E*s(M) + 0.01608*s(2*Mp) + 0.01039*s(2*F) +
+		0.00739*E*s(Mp-M) - 0.00514*E*s(Mp+M) + 0.00208*E*E*s(2*M) - 0.00111*s(Mp-2*F) -
+		0.00057*s(Mp+2*F) + 0.00056*E*s(2*Mp+M) - 0.00042*s(3*Mp) + 0.00042*E*s(M+2*F) +
+		0.00038*E*s(M-2*F) - 0.00024*E*s(2*Mp-M) - 0.00017*s(O) - 0.00007*s(Mp+2*M) +
+		0.00004*s(2*Mp-2*F) + 0.00004*s(3*M) + 0.00003*s(Mp+M-2*F) + 0.00003*s(2*Mp+2*F) -
+		0.00003*s(Mp+M+2*F) + 0.00003*s(Mp-M+2*F) - 0.00002*s(Mp-M-2*F) - 0.00002*s(3*Mp+M) +
+		0.00002*s(4*Mp)
+	A := [][3]float64{
+		{299.77, 0.107408, 0.000325}, {251.88, 0.016321, 0.000165}, {251.83, 26.651886, 0.000164},
+		{349.42, 36.412478, 0.000126}, {84.66, 18.206239, 0.000110}, {141.74, 53.303771, 0.000062},
+		{207.14, 2.453732, 0.000060}, {154.84, 7.306860, 0.000056}, {34.52, 27.261239, 0.000047},
+		{207.19, 0.121824, 0.000042}, {291.34, 1.844379, 0.000040}, {161.72, 24.198154, 0.000037},
+		{239.56, 25.513099, 0.000035}, {331.55, 3.592518, 0.000023},
+	}
+	for i, a := range A {
+		arg := a[0] + a[1]*k
+		if i == 0 {
+			arg -= 0.009173 * T2
+		}
+		c += a[2] * s(arg*rad)
+	}
+	return jde + c
+}
+
+// deltaT returns TT - UT in seconds (Espenak and Meeus).
+func deltaT(y float64) float64 {
+	switch {
+	case y < 1900:
+		t := y - 1860
+		return 7.62 + 0.5737*t - 0.251754*t*t + 0.01680668*t*t*t - 0.0004473624*t*t*t*t + t*t*t*t*t/233174
+	case y < 1920:
+		t := y - 1900
+		return -2.79 + 1.494119*t - 0.0598939*t*t + 0.0061966*t*t*t - 0.000197*t*t*t*t
+	case y < 1941:
+		t := y - 1920
+		return 21.20 + 0.84493*t - 0.076100*t*t + 0.0020936*t*t*t
+	case y < 1961:
+		t := y - 1950
+		return 29.07 + 0.407*t - t*t/233 + t*t*t/2547
+	case y < 1986:
+		t := y - 1975
+		return 45.45 + 1.067*t - t*t/260 - t*t*t/718
+	case y < 2005:
+		t := y - 2000
+		return 63.86 + 0.3345*t - 0.060374*t*t + 0.0017275*t*t*t + 0.000651814*t*t*t*t + 0.00002373599*t*t*t*t*t
+	case y < 2050:
+		t := y - 2000
+		return 62.92 + 0.32217*t + 0.005589*t*t
+	default:
+		u := (y - 1820) / 100
+		return -20 + 32*u*u - 0.5628*(2150-y)
+	}
+}
@@ -0,0 +1,306 @@
+package timex_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/invzhi/timex"
+)
+
+func TestChineseCalendar(t *testing.T) {
+	var calendar timex.ChineseCalendar
+
+	tests := []struct {
+		year, month int
+		leap        bool
+		day         int
+		date        timex.Date
+	}{
+		{1900, 1, false, 1, timex.MustNewDate(1900, 1, 31)},
+		{1900, 8, true, 1, timex.MustNewDate(1900, 9, 24)},
+		{1949, 8, false, 10, timex.MustNewDate(1949, 10, 1)},
+		{2000, 1, false, 1, timex.MustNewDate(2000, 2, 5)},
+		{2020, 4, true, 1, timex.MustNewDate(2020, 5, 23)},
+		{2023, 1, false, 1, timex.MustNewDate(2023, 1, 22)},
+		{2023, 2, false, 1, timex.MustNewDate(2023, 2, 20)},
+		{2023, 2, true, 1, timex.MustNewDate(2023, 3, 22)},
+		{2023, 3, false, 1, timex.MustNewDate(2023, 4, 20)},
+		{2024, 1, false, 1, timex.MustNewDate(2024, 2, 10)},
+		{2025, 1, false, 1, timex.MustNewDate(2025, 1, 29)},
+		{2025, 6, true, 1, timex.MustNewDate(2025, 7, 25)},
+		{2033, 11, true, 1, timex.MustNewDate(2033, 12, 22)},
+		{2033, 12, false, 1, timex.MustNewDate(2034, 1, 20)},
+		{2100, 12, false, 29, timex.MustNewDate(2101, 1, 28)},
+	}
+
+	for _, tt := range tests {
+		month, err := calendar.Month(tt.year, tt.month, tt.leap)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.date, calendar.MustNewDate(tt.year, month, tt.day))
+
+		year, m, day := calendar.Date(tt.date)
+		assert.Equal(t, tt.year, year)
+		assert.Equal(t, month, m)
+		assert.Equal(t, tt.day, day)
+
+		number, leap := calendar.MonthNumber(year, m)
+		assert.Equal(t, tt.month, number)
+		assert.Equal(t, tt.leap, leap)
+	}
+
+	t.Run("LeapMonth", func(t *testing.T) {
+		var months []int
+		for year := 2017; year <= 2036; year++ {
+			months = append(months, calendar.LeapMonth(year))
+		}
+		assert.Equal(t, []int{6, 0, 0, 4, 0, 0, 2, 0, 6, 0, 0, 5, 0, 0, 3, 0, 11, 0, 0, 6}, months)
+		assert.True(t, calendar.IsLeapYear(2023))
+		assert.False(t, calendar.IsLeapYear(2024))
+		assert.False(t, calendar.IsLeapYear(1899))
+
+		assert.Equal(t, 13, calendar.MonthsInYear(2023))
+		assert.Equal(t, 12, calendar.MonthsInYear(2024))
+		assert.Equal(t, 0, calendar.MonthsInYear(1899))
+
+		assert.Equal(t, 29, calendar.DaysInMonth(2023, 3))
+		assert.Equal(t, 0, calendar.DaysInMonth(2024, 13))
+		assert.Equal(t, 0, calendar.DaysInMonth(2101, 1))
+
+		assert.Equal(t, "闰二月", calendar.MonthName(2023, 3))
+		assert.Equal(t, "三月", calendar.MonthName(2023, 4))
+		assert.Equal(t, "", calendar.MonthName(2024, 13))
+
+		number, leap := calendar.MonthNumber(2024, 13)
+		assert.Equal(t, 0, number)
+		assert.False(t, leap)
+	})
+
+	t.Run("Calendar", func(t *testing.T) {
+		var c timex.Calendar = calendar
+		date := timex.MustDateIn(c, 2023, 3, 1)
+		assert.Equal(t, timex.MustNewDate(2023, 3, 22), date)
+		assert.Equal(t, "2023年闰二月1日", date.FormatIn(c, "YYYY年MMMMD日"))
+
+		d, err := timex.ParseDateIn(c, "YYYY年MMMMD日", "2023年闰二月1日")
+		assert.NoError(t, err)
+		assert.Equal(t, date, d)
+	})
+
+	t.Run("Continuous", func(t *testing.T) {
+		date := timex.MustNewDate(1900, 1, 31)
+		prevYear, prevMonth, prevDay := calendar.Date(date)
+		for date = date.AddDays(1); date != timex.MustNewDate(2101, 1, 29); date = date.AddDays(1) {
+			year, month, day := calendar.Date(date)
+			assert.Equal(t, date, calendar.MustNewDate(year, month, day))
+
+			if day == 1 {
+				assert.Contains(t, []int{29, 30}, prevDay)
+				assert.True(t, year == prevYear && month == prevMonth+1 ||
+					year == prevYear+1 && month == 1 && prevMonth == calendar.MonthsInYear(prevYear))
+			} else {
+				assert.Equal(t, prevDay+1, day)
+			}
+			prevYear, prevMonth, prevDay = year, month, day
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		tests := []struct {
+			year, month, day int
+			err              string
+		}{
+			{1899, 1, 1, "year is out of range [1900,2100]"},
+			{2101, 1, 1, "year is out of range [1900,2100]"},
+			{2024, 0, 1, "month is out of range [1,12]"},
+			{2024, 13, 1, "month is out of range [1,12]"},
+			{2023, 14, 1, "month is out of range [1,13]"},
+			{2023, 3, 30, "day is out of range [1,29]"},
+			{2024, 1, 0, "day is out of range [1,29]"},
+		}
+
+		for _, tt := range tests {
+			_, err := calendar.NewDate(tt.year, tt.month, tt.day)
+			assert.EqualError(t, err, tt.err)
+		}
+
+		assert.PanicsWithValue(t, "timex: ChineseCalendar.NewDate: month is out of range [1,12]", func() {
+			calendar.MustNewDate(2024, 13, 1)
+		})
+
+		monthTests := []struct {
+			year, number int
+			leap         bool
+			err          string
+		}{
+			{1899, 1, false, "year is out of range [1900,2100]"},
+			{2024, 13, false, "month is out of range [1,12]"},
+			{2024, 2, true, "month 2 is not a leap month"},
+			{2023, 3, true, "month 3 is not a leap month"},
+		}
+
+		for _, tt := range monthTests {
+			_, err := calendar.Month(tt.year, tt.number, tt.leap)
+			assert.EqualError(t, err, tt.err)
+		}
+
+		for _, date := range []timex.Date{timex.MustNewDate(1900, 1, 30), timex.MustNewDate(2101, 1, 29)} {
+			year, month, day := calendar.Date(date)
+			assert.Equal(t, [3]int{0, 0, 0}, [3]int{year, month, day})
+		}
+	})
+}
+
+func TestChineseCalendarFormat(t *testing.T) {
+	tests := []struct {
+		calendar timex.ChineseCalendar
+		layout   string
+		date     timex.Date
+		value    string
+	}{
+		{timex.ChineseCalendar{}, "YYYY-MM-DD", timex.MustNewDate(2024, 2, 10), "2024-01-01"},
+		{timex.ChineseCalendar{}, "YYYY-MM-DD", timex.MustNewDate(2023, 4, 20), "2023-04-01"},
+		{timex.ChineseCalendar{}, "YYYY年MMMMD日", timex.MustNewDate(2024, 2, 10), "2024年正月1日"},
+		{timex.ChineseCalendar{}, "YYYY年MMMMD日", timex.MustNewDate(2023, 3, 22), "2023年闰二月1日"},
+		{timex.ChineseCalendar{}, "YYYY年MMMMD日", timex.MustNewDate(2023, 4, 20), "2023年三月1日"},
+		{timex.ChineseCalendar{}, "YYYY年MMMD日", timex.MustNewDate(2024, 2, 8), "2023年腊月29日"},
+		{timex.ChineseCalendar{Traditional: true}, "YYYY年MMMMD日", timex.MustNewDate(2023, 3, 22), "2023年閏二月1日"},
+		{timex.ChineseCalendar{Traditional: true}, "YYYY年MMMMD日", timex.MustNewDate(2024, 2, 8), "2023年臘月29日"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.value, tt.calendar.Format(tt.date, tt.layout))
+
+		date, err := tt.calendar.Parse(tt.layout, tt.value)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.date, date)
+	}
+
+	assert.Equal(t, "0000-00-00", timex.ChineseCalendar{}.Format(timex.MustNewDate(1900, 1, 1), "YYYY-MM-DD"))
+
+	_, err := timex.ChineseCalendar{}.Parse("YYYY年MMMMD日", "2024年闰二月1日")
+	assert.EqualError(t, err, `parsing "2024年闰二月1日" as "YYYY年MMMMD日": cannot parse "闰二月1日" as "MMMM"`)
+}
+
+func TestChineseCalendarSolarTerm(t *testing.T) {
+	var calendar timex.ChineseCalendar
+
+	tests := []struct {
+		year int
+		term timex.SolarTerm
+		date timex.Date
+	}{
+		{2024, timex.SolarTermMinorCold, timex.MustNewDate(2024, 1, 6)},
+		{2024, timex.SolarTermStartOfSpring, timex.MustNewDate(2024, 2, 4)},
+		{2024, timex.SolarTermSpringEquinox, timex.MustNewDate(2024, 3, 20)},
+		{2024, timex.SolarTermPureBrightness, timex.MustNewDate(2024, 4, 4)},
+		{2024, timex.SolarTermSummerSolstice, timex.MustNewDate(2024, 6, 21)},
+		{2024, timex.SolarTermAutumnEquinox, timex.MustNewDate(2024, 9, 22)},
+		{2024, timex.SolarTermWinterSolstice, timex.MustNewDate(2024, 12, 21)},
+		{2025, timex.SolarTermStartOfSpring, timex.MustNewDate(2025, 2, 3)},
+		{2025, timex.SolarTermGrainRain, timex.MustNewDate(2025, 4, 20)},
+	}
+
+	for _, tt := range tests {
+		date, err := calendar.SolarTerm(tt.year, tt.term)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.date, date)
+
+		term, ok := calendar.SolarTermOn(tt.date)
+		assert.True(t, ok)
+		assert.Equal(t, tt.term, term)
+	}
+
+	_, ok := calendar.SolarTermOn(timex.MustNewDate(2024, 4, 5))
+	assert.False(t, ok)
+
+	_, err := calendar.SolarTerm(2101, timex.SolarTermMinorCold)
+	assert.EqualError(t, err, "year is out of range [1900,2100]")
+	_, err = calendar.SolarTerm(2024, 0)
+	assert.EqualError(t, err, "unknown solar term")
+
+	assert.Equal(t, "Pure Brightness", timex.SolarTermPureBrightness.String())
+	assert.Equal(t, "%!SolarTerm(25)", timex.SolarTerm(25).String())
+
+	// The principal terms are in the months numbered by them.
+	for year := 1901; year <= 2100; year++ {
+		date, err := calendar.SolarTerm(year, timex.SolarTermWinterSolstice)
+		assert.NoError(t, err)
+		year, month, _ := calendar.Date(date)
+		number, leap := calendar.MonthNumber(year, month)
+		assert.Equal(t, 11, number)
+		assert.False(t, leap)
+	}
+}
+
+func TestChineseCalendarSexagenary(t *testing.T) {
+	var calendar timex.ChineseCalendar
+
+	tests := []struct {
+		year   int
+		s      string
+		zodiac string
+	}{
+		{4, "甲子", "Rat"},
+		{1984, "甲子", "Rat"},
+		{2023, "癸卯", "Rabbit"},
+		{2024, "甲辰", "Dragon"},
+		{2025, "乙巳", "Snake"},
+		{-1, "己未", "Goat"},
+	}
+
+	for _, tt := range tests {
+		s := calendar.YearSexagenary(tt.year)
+		assert.Equal(t, tt.s, s.String())
+		assert.Equal(t, tt.zodiac, s.Zodiac().String())
+	}
+
+	assert.Equal(t, "甲子", calendar.DaySexagenary(timex.MustNewDate(1949, 10, 1)).String())
+	assert.Equal(t, "戊午", calendar.DaySexagenary(timex.MustNewDate(2000, 1, 1)).String())
+
+	s := calendar.YearSexagenary(2024)
+	assert.Equal(t, "甲", s.Stem().String())
+	assert.Equal(t, "辰", s.Branch().String())
+	assert.Equal(t, "%!Sexagenary(60)", timex.Sexagenary(60).String())
+	assert.Equal(t, "%!HeavenlyStem(10)", timex.HeavenlyStem(10).String())
+	assert.Equal(t, "%!EarthlyBranch(-1)", timex.EarthlyBranch(-1).String())
+	assert.Equal(t, "%!ChineseZodiac(12)", timex.ChineseZodiac(12).String())
+}
+
+func TestChineseCalendarHoliday(t *testing.T) {
+	var calendar timex.ChineseCalendar
+
+	tests := []struct {
+		year    int
+		holiday timex.ChineseHoliday
+		date    timex.Date
+	}{
+		{2024, timex.ChineseHolidaySpringFestival, timex.MustNewDate(2024, 2, 10)},
+		{2024, timex.ChineseHolidayLanternFestival, timex.MustNewDate(2024, 2, 24)},
+		{2024, timex.ChineseHolidayQingming, timex.MustNewDate(2024, 4, 4)},
+		{2024, timex.ChineseHolidayDragonBoat, timex.MustNewDate(2024, 6, 10)},
+		{2024, timex.ChineseHolidayQixi, timex.MustNewDate(2024, 8, 10)},
+		{2024, timex.ChineseHolidayMidAutumn, timex.MustNewDate(2024, 9, 17)},
+		{2024, timex.ChineseHolidayDoubleNinth, timex.MustNewDate(2024, 10, 11)},
+		{2024, timex.ChineseHolidayDongzhi, timex.MustNewDate(2024, 12, 21)},
+		{2024, timex.ChineseHolidayNewYearsEve, timex.MustNewDate(2024, 2, 9)},
+		{2025, timex.ChineseHolidaySpringFestival, timex.MustNewDate(2025, 1, 29)},
+		{2025, timex.ChineseHolidayDragonBoat, timex.MustNewDate(2025, 5, 31)},
+		{2025, timex.ChineseHolidayMidAutumn, timex.MustNewDate(2025, 10, 6)},
+		{1900, timex.ChineseHolidayNewYearsEve, timex.MustNewDate(1900, 1, 30)},
+	}
+
+	for _, tt := range tests {
+		date, err := calendar.Holiday(tt.year, tt.holiday)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.date, date, tt.holiday.String())
+	}
+
+	_, err := calendar.Holiday(2024, 0)
+	assert.EqualError(t, err, "unknown holiday")
+	_, err = calendar.Holiday(1899, timex.ChineseHolidayMidAutumn)
+	assert.EqualError(t, err, "year is out of range [1900,2100]")
+
+	assert.Equal(t, "Mid-Autumn Festival", timex.ChineseHolidayMidAutumn.String())
+	assert.Equal(t, "%!ChineseHoliday(10)", timex.ChineseHoliday(10).String())
+}
@@ -1,8 +1,6 @@
 package timex
 
 import (
-	"errors"
-	"fmt"
 	"math"
 	"time"
 )
@@ -17,11 +15,11 @@ type Date struct {
 // NewDate returns the date corresponding to year, month, and day.
 func NewDate(year, month, day int) (Date, error) {
 	if month < 1 || month > 12 {
-		return Date{}, errors.New("month is out of range [1,12]")
+		return Date{}, rangeError(KindMonthOutOfRange, month, 1, 12)
 	}
 
 	if days := daysInMonth(year, month); day < 1 || day > days {
-		return Date{}, fmt.Errorf("day is out of range [1,%d]", days)
+		return Date{}, rangeError(KindDayOutOfRange, day, 1, days)
 	}
 
 	n := calendarToOrdinal(year, month, day)
@@ -39,13 +37,9 @@ func MustNewDate(year, month, day int) Date {
 
 // DateFromOrdinalDate returns the date corresponding to year, day of year.
 func DateFromOrdinalDate(year, dayOfYear int) (Date, error) {
-	days := 365
-	if isLeap(year) {
-		days++
-	}
-
+	days := daysInYearOf(year)
 	if dayOfYear < 1 || dayOfYear > days {
-		return Date{}, fmt.Errorf("day of year is out of range [1,%d]", days)
+		return Date{}, &RangeError{Kind: KindDayOutOfRange, Elem: "day of year", Value: dayOfYear, Min: 1, Max: days}
 	}
 
 	n := ordinalDateToOrdinal(year, dayOfYear)
@@ -61,6 +55,43 @@ func MustDateFromOrdinalDate(year, dayOfYear int) Date {
 	return date
 }
 
+// NthWeekdayOfMonth returns the n-th weekday in the month of year.
+// If n is negative, it counts from the end of the month, so -1 is the last weekday of the month.
+// The error of n out of range is a *RangeError of KindWeekOutOfRange.
+func NthWeekdayOfMonth(year, month, n int, weekday time.Weekday) (Date, error) {
+	first, err := NewDate(year, month, 1)
+	if err != nil {
+		return Date{}, err
+	}
+
+	first = first.NextOrSame(weekday)
+	count := (daysInMonth(year, month)-first.Day())/7 + 1
+	switch {
+	case n > 0 && n <= count:
+		return first.AddDays((n - 1) * 7), nil
+	case n < 0 && n >= -count:
+		return first.AddDays((count + n) * 7), nil
+	case n < 0:
+		return Date{}, &RangeError{Kind: KindWeekOutOfRange, Elem: "n", Value: n, Min: -count, Max: -1}
+	default:
+		return Date{}, &RangeError{Kind: KindWeekOutOfRange, Elem: "n", Value: n, Min: 1, Max: count}
+	}
+}
+
+// MustNthWeekdayOfMonth is like NthWeekdayOfMonth but panics if the date cannot be created.
+func MustNthWeekdayOfMonth(year, month, n int, weekday time.Weekday) Date {
+	date, err := NthWeekdayOfMonth(year, month, n, weekday)
+	if err != nil {
+		panic(`timex: NthWeekdayOfMonth: ` + err.Error())
+	}
+	return date
+}
+
+// LastWeekdayOfMonth returns the last weekday in the month of year.
+func LastWeekdayOfMonth(year, month int, weekday time.Weekday) (Date, error) {
+	return NthWeekdayOfMonth(year, month, -1, weekday)
+}
+
 // DateFromTime returns the date specified by t.
 func DateFromTime(t time.Time) Date {
 	year, month, day := t.Date()
@@ -142,6 +173,98 @@ func (d Date) ISOWeek() (year, week int) {
 	return year, (dayOfYear-1)/7 + 1
 }
 
+// WeekdayOccurrence returns n if d is the n-th weekday of its month, n is in [1,5].
+func (d Date) WeekdayOccurrence() int {
+	_, _, day := ordinalToCalendar(d.ordinal)
+	return (day-1)/7 + 1
+}
+
+// Next returns the first date after d which is the given weekday.
+func (d Date) Next(weekday time.Weekday) Date {
+	return d.AddDays(1).NextOrSame(weekday)
+}
+
+// NextOrSame returns the first date on or after d which is the given weekday.
+func (d Date) NextOrSame(weekday time.Weekday) Date {
+	delta := (int(weekday)%7 - int(d.Weekday()) + 7) % 7
+	return Date{ordinal: d.ordinal + delta}
+}
+
+// Previous returns the last date before d which is the given weekday.
+func (d Date) Previous(weekday time.Weekday) Date {
+	return d.AddDays(-1).PreviousOrSame(weekday)
+}
+
+// PreviousOrSame returns the last date on or before d which is the given weekday.
+func (d Date) PreviousOrSame(weekday time.Weekday) Date {
+	return d.StartOfWeek(weekday)
+}
+
+// IsLeapYear reports whether the year specified by d is a leap year.
+func (d Date) IsLeapYear() bool {
+	return isLeap(d.Year())
+}
+
+// DaysInMonth returns the number of days in the month specified by d.
+func (d Date) DaysInMonth() int {
+	year, month, _ := ordinalToCalendar(d.ordinal)
+	return daysInMonth(year, month)
+}
+
+// DaysInYear returns the number of days in the year specified by d.
+func (d Date) DaysInYear() int {
+	return daysInYearOf(d.Year())
+}
+
+// StartOfWeek returns the first day of the week specified by d, the week starts on firstDay.
+func (d Date) StartOfWeek(firstDay time.Weekday) Date {
+	delta := (int(d.Weekday()) - int(firstDay)%7 + 7) % 7
+	return Date{ordinal: d.ordinal - delta}
+}
+
+// StartOfISOWeek returns the monday of the ISO 8601 week specified by d.
+func (d Date) StartOfISOWeek() Date {
+	return d.StartOfWeek(time.Monday)
+}
+
+// StartOfMonth returns the first day of the month specified by d.
+func (d Date) StartOfMonth() Date {
+	_, _, day := ordinalToCalendar(d.ordinal)
+	return Date{ordinal: d.ordinal - day + 1}
+}
+
+// EndOfMonth returns the last day of the month specified by d.
+func (d Date) EndOfMonth() Date {
+	year, month, day := ordinalToCalendar(d.ordinal)
+	return Date{ordinal: d.ordinal - day + daysInMonth(year, month)}
+}
+
+// StartOfQuarter returns the first day of the quarter specified by d.
+func (d Date) StartOfQuarter() Date {
+	year, month, _ := ordinalToCalendar(d.ordinal)
+	month -= (month - 1) % 3
+	return Date{ordinal: calendarToOrdinal(year, month, 1)}
+}
+
+// EndOfQuarter returns the last day of the quarter specified by d.
+func (d Date) EndOfQuarter() Date {
+	year, month, _ := ordinalToCalendar(d.ordinal)
+	month += 2 - (month-1)%3
+	return Date{ordinal: calendarToOrdinal(year, month, daysInMonth(year, month))}
+}
+
+// StartOfYear returns the first day of the year specified by d.
+func (d Date) StartOfYear() Date {
+	_, dayOfYear := ordinalToOrdinalDate(d.ordinal)
+	return Date{ordinal: d.ordinal - dayOfYear + 1}
+}
+
+// EndOfYear returns the last day of the year specified by d.
+func (d Date) EndOfYear() Date {
+	year, dayOfYear := ordinalToOrdinalDate(d.ordinal)
+	return Date{ordinal: d.ordinal - dayOfYear + daysInYearOf(year)}
+}
+
 // norm1 normalize the hi and lo into [1, base].
 func norm1(hi, lo, base int) (int, int) {
 	if lo < 1 {
@@ -233,6 +356,14 @@ func isLeap(year int) bool {
 	return year%4 == 0 && (year%100 != 0 || year%400 == 0)
 }
 
+// daysInYearOf returns the number of days in the specified year.
+func daysInYearOf(year int) int {
+	if isLeap(year) {
+		return 366
+	}
+	return 365
+}
+
 // daysInMonth returns the number of days in the specified month.
 func daysInMonth(year, month int) int {
 	if month == 2 && isLeap(year) {
@@ -1,6 +1,10 @@
 package timex
 
-import "errors"
+import (
+	"errors"
+	"strings"
+	"time"
+)
 
 const (
 	tokenYearTwoDigit = iota + 1
@@ -11,6 +15,13 @@ const (
 	tokenMonthLongName
 	tokenDayOfMonth
 	tokenDayOfMonthTwoDigit
+	tokenWeekYear
+	tokenWeek
+	tokenWeekday
+	tokenEraShortName
+	tokenEraLongName
+	tokenEraYear
+	tokenEraYearTwoDigit
 )
 
 const (
@@ -50,6 +61,44 @@ var monthLongNames = []string{
 	"December",
 }
 
+// dateNames is the names used in a calendar, the abbreviated and full names of months and eras.
+type dateNames struct {
+	short, long       []string
+	eraShort, eraLong []string
+	calendar          Calendar // calendar gives the names of months if it is not nil.
+}
+
+// monthName returns the abbreviated or full name of the month in the year.
+func (n dateNames) monthName(year, month int, short bool) string {
+	switch {
+	case n.calendar == nil && short:
+		return n.short[month-1]
+	case n.calendar == nil:
+		return n.long[month-1]
+	}
+	if namer, ok := n.calendar.(monthShortNamer); ok && short {
+		return namer.MonthShortName(year, month)
+	}
+	return n.calendar.MonthName(year, month)
+}
+
+// monthNames returns the abbreviated or full names of months in the year.
+func (n dateNames) monthNames(year int, short bool) []string {
+	switch {
+	case n.calendar == nil && short:
+		return n.short
+	case n.calendar == nil:
+		return n.long
+	}
+	names := make([]string, n.calendar.MonthsInYear(year))
+	for i := range names {
+		names[i] = n.monthName(year, i+1, short)
+	}
+	return names
+}
+
+var gregorianMonthNames = dateNames{short: monthShortNames, long: monthLongNames}
+
 func nextDateToken(layout string) (prefix string, token int, suffix string) {
 	for i := 0; i < len(layout); i++ {
 		switch layout[i] {
@@ -80,6 +129,32 @@ func nextDateToken(layout string) (prefix string, token int, suffix string) {
 			if len(layout) >= i+1 && layout[i:i+1] == "D" {
 				return layout[:i], tokenDayOfMonth, layout[i+1:]
 			}
+		case 'I': // IYYY, IW, ID
+			if len(layout) >= i+4 && layout[i:i+4] == "IYYY" {
+				return layout[:i], tokenWeekYear, layout[i+4:]
+			}
+			if len(layout) >= i+2 && layout[i:i+2] == "IW" {
+				return layout[:i], tokenWeek, layout[i+2:]
+			}
+			if len(layout) >= i+2 && layout[i:i+2] == "ID" {
+				return layout[:i], tokenWeekday, layout[i+2:]
+			}
+		case 'G': // G, GGGG
+			if !isEraWord(layout, i) {
+				continue
+			}
+			if len(layout) >= i+4 && layout[i:i+4] == "GGGG" {
+				return layout[:i], tokenEraLongName, layout[i+4:]
+			}
+			return layout[:i], tokenEraShortName, layout[i+1:]
+		case 'e': // e, ee
+			if !isEraWord(layout, i) {
+				continue
+			}
+			if len(layout) >= i+2 && layout[i:i+2] == "ee" {
+				return layout[:i], tokenEraYearTwoDigit, layout[i+2:]
+			}
+			return layout[:i], tokenEraYear, layout[i+1:]
 		}
 	}
 	return layout, 0, ""
@@ -122,13 +197,192 @@ func parseStrictRFC3339Date(b []byte) (Date, error) {
 //	MMMM  January-December  The full month name
 //	D      1-31             Day of month
 //	DD    01-31             Day of month, 2-digits
+//	IYYY   2001             Four-digit ISO 8601 week-based year
+//	IW    01-53             ISO 8601 week, 2-digits
+//	ID      1-7             ISO 8601 day of week, beginning at 1 on Monday
+//
+// The letter I is a literal unless it starts IYYY, IW or ID.
 func ParseDate(layout, value string) (Date, error) {
-	var year, month, day int
+	f, err := parseDate(layout, value, elemYear|elemMonth|elemDay|elemWeek, gregorianMonthNames)
+	if err != nil {
+		return Date{}, err
+	}
+	return f.date(isoWeekScheme)
+}
+
+const (
+	elemYear = 1 << iota
+	elemMonth
+	elemDay
+	elemWeek
+	elemEra
+)
+
+// isEraWord reports whether the ASCII letters around index i of layout are only G and e,
+// so that the era tokens in words such as "Generation" are literals.
+func isEraWord(layout string, i int) bool {
+	isLetter := func(c byte) bool {
+		return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z'
+	}
+	for j := i - 1; j >= 0 && isLetter(layout[j]); j-- {
+		if layout[j] != 'G' && layout[j] != 'e' {
+			return false
+		}
+	}
+	for j := i + 1; j < len(layout) && isLetter(layout[j]); j++ {
+		if layout[j] != 'G' && layout[j] != 'e' {
+			return false
+		}
+	}
+	return true
+}
+
+// dateFields is the elements of date parsed from or formatted to a string.
+type dateFields struct {
+	year, month, day        int
+	weekYear, week, weekday int // weekday is the day of week beginning at 1 on the first day of week.
+	era, eraYear            int // era is the index of era in the names.
+	elems                   int // elems is the elements parsed from the string, or the elements computed to format.
+	hasWeekYear, hasEra     bool
+
+	// d and scheme compute the week elements when they are formatted.
+	d      Date
+	scheme WeekScheme
+
+	// layout, value and spans locate the range errors of the parsed elements.
+	layout, value string
+	spans         elemSpans
+}
+
+// parseError wraps the range error err of the parsed elements in a *ParseError at the element,
+// other errors are returned unchanged.
+func (f *dateFields) parseError(err error) error {
+	return f.spans.parseError(f.layout, f.value, err)
+}
+
+// fields returns the elements of the date d, the week elements are computed in the week scheme when needed.
+func (d Date) fields(scheme WeekScheme) dateFields {
+	var f dateFields
+	f.year, f.month, f.day = ordinalToCalendar(d.ordinal)
+	f.elems = elemYear | elemMonth | elemDay
+	f.d, f.scheme = d, scheme
+	return f
+}
+
+// weekFields computes the week elements to format.
+func (f *dateFields) weekFields() {
+	if f.elems&elemWeek != 0 {
+		return
+	}
+	f.weekYear, f.week = f.scheme.Week(f.d)
+	f.weekday = f.scheme.DayOfWeek(f.d)
+	f.elems |= elemWeek
+}
+
+// date returns the date the parsed elements represent.
+// The date is built from the week elements in the week scheme if any of them is parsed,
+// the week-based year defaults to the year and the day of week defaults to the first day of week.
+func (f dateFields) date(scheme WeekScheme) (Date, error) {
+	if f.elems&elemWeek == 0 {
+		date, err := NewDate(f.year, f.month, f.day)
+		return date, f.parseError(err)
+	}
 
+	year := f.weekYear
+	if !f.hasWeekYear {
+		year = f.year
+	}
+	weekday := 1
+	if f.weekday != 0 {
+		weekday = f.weekday
+	}
+	date, err := scheme.Date(year, f.week, time.Weekday((int(scheme.FirstDay)+weekday-1)%7))
+	return date, f.parseError(err)
+}
+
+// dateTokenKind returns the kind of the range error of the element the token represents, or KindSyntax if none.
+func dateTokenKind(token int) ErrorKind {
+	switch token {
+	case tokenYearTwoDigit, tokenYearFourDigit, tokenEraYear, tokenEraYearTwoDigit:
+		return KindYearOutOfRange
+	case tokenMonth, tokenMonthTwoDigit, tokenMonthShortName, tokenMonthLongName:
+		return KindMonthOutOfRange
+	case tokenDayOfMonth, tokenDayOfMonthTwoDigit:
+		return KindDayOutOfRange
+	case tokenWeek:
+		return KindWeekOutOfRange
+	default:
+		return KindSyntax
+	}
+}
+
+// dateTokenElem returns the element of date the token represents.
+func dateTokenElem(token int) int {
+	switch token {
+	case tokenYearTwoDigit, tokenYearFourDigit:
+		return elemYear
+	case tokenMonth, tokenMonthTwoDigit, tokenMonthShortName, tokenMonthLongName:
+		return elemMonth
+	case tokenWeekYear, tokenWeek, tokenWeekday:
+		return elemWeek
+	case tokenEraShortName, tokenEraLongName, tokenEraYear, tokenEraYearTwoDigit:
+		return elemEra
+	default:
+		return elemDay
+	}
+}
+
+// nextDateElemToken is like nextDateToken, but the tokens of elements not in elems are part of prefix.
+func nextDateElemToken(layout string, elems int) (prefix string, token int, suffix string) {
+	var n int
+	for {
+		prefix, token, suffix = nextDateToken(layout[n:])
+		if token == 0 || elems&dateTokenElem(token) != 0 {
+			return layout[:n+len(prefix)], token, suffix
+		}
+		n = len(layout) - len(suffix)
+	}
+}
+
+// parseDate parses the elements of date from value, the tokens of elements not in elems are parsed as literal.
+func parseDate(layout, value string, elems int, names dateNames) (dateFields, error) {
+	f, _, _, err := parseDateFields(layout, value, elems, names, ParseOptions{})
+	return f, err
+}
+
+// parseDateExact is like parseDate, but the value after the last element must be the literal at the end of layout.
+func parseDateExact(layout, value string, elems int, names dateNames) (dateFields, error) {
+	f, rest, literal, err := parseDateFields(layout, value, elems, names, ParseOptions{})
+	if err != nil {
+		return dateFields{}, err
+	}
+	if err := checkTrailing(layout, value, rest, literal); err != nil {
+		return dateFields{}, err
+	}
+	return f, nil
+}
+
+// checkTrailing returns an error if the rest of value after the last element is not the literal at the end of layout.
+func checkTrailing(layout, value, rest, literal string) error {
+	switch {
+	case rest == literal:
+		return nil
+	case strings.HasPrefix(rest, literal):
+		offset := len(value) - len(rest) + len(literal)
+		return &ParseError{Layout: layout, Value: value, ValueElem: value[offset:], Offset: offset, Kind: KindTrailingData}
+	default:
+		return &ParseError{Layout: layout, Value: value, LayoutElem: literal, ValueElem: rest, Offset: len(value) - len(rest)}
+	}
+}
+
+// parseDateFields is like parseDate with the options,
+// it also returns the value after the last element and the literal at the end of layout.
+func parseDateFields(layout, value string, elems int, names dateNames, o ParseOptions) (f dateFields, rest, literal string, err error) {
 	originLayout, originValue := layout, value
+	f.layout, f.value = originLayout, originValue
 	var layoutElem, valueElem string
 	for {
-		prefix, token, suffix := nextDateToken(layout)
+		prefix, token, suffix := nextDateElemToken(layout, elems)
 		if token == 0 {
 			break
 		}
@@ -136,13 +390,14 @@ func ParseDate(layout, value string) (Date, error) {
 		layoutElem = layout[len(prefix) : len(layout)-len(suffix)]
 
 		layout = suffix
-		if len(value) < len(prefix) {
-			return Date{}, &ParseError{Layout: originLayout, Value: originValue, LayoutElem: layoutElem, ValueElem: valueElem}
-		}
-		if value[:len(prefix)] != prefix {
-			return Date{}, &ParseError{Layout: originLayout, Value: originValue, LayoutElem: prefix, ValueElem: value}
+		after, matched := o.cutLiteral(value, prefix)
+		switch {
+		case !matched && len(value) < len(prefix):
+			return dateFields{}, "", "", &ParseError{Layout: originLayout, Value: originValue, LayoutElem: layoutElem, ValueElem: valueElem, Offset: len(originValue) - len(valueElem)}
+		case !matched:
+			return dateFields{}, "", "", &ParseError{Layout: originLayout, Value: originValue, LayoutElem: prefix, ValueElem: value, Offset: len(originValue) - len(value)}
 		}
-		value = value[len(prefix):]
+		value = after
 
 		valueElem = value
 
@@ -150,38 +405,61 @@ func ParseDate(layout, value string) (Date, error) {
 
 		switch token {
 		case tokenYearTwoDigit:
-			year, value, ok = atoi(value, 2, 2)
-			if year >= 69 {
-				year += 1900
-			} else {
-				year += 2000
-			}
+			f.year, value, ok = atoi(value, o.padded(2), 2)
+			f.year = o.twoDigitYear(f.year)
 		case tokenYearFourDigit:
-			year, value, ok = atoi(value, 4, 4)
+			f.year, value, ok = atoi(value, 4, 4)
 		case tokenMonth:
-			month, value, ok = atoi(value, 1, 2)
+			f.month, value, ok = o.atoiUnpadded(value, 2)
 		case tokenMonthTwoDigit:
-			month, value, ok = atoi(value, 2, 2)
+			f.month, value, ok = atoi(value, o.padded(2), 2)
 		case tokenMonthShortName:
 			var index int
-			index, value, ok = searchName(monthShortNames, value)
-			month = index + 1
+			index, value, ok = searchName(names.monthNames(f.year, true), value)
+			f.month = index + 1
 		case tokenMonthLongName:
 			var index int
-			index, value, ok = searchName(monthLongNames, value)
-			month = index + 1
+			index, value, ok = searchName(names.monthNames(f.year, false), value)
+			f.month = index + 1
 		case tokenDayOfMonth:
-			day, value, ok = atoi(value, 1, 2)
+			f.day, value, ok = o.atoiUnpadded(value, 2)
 		case tokenDayOfMonthTwoDigit:
-			day, value, ok = atoi(value, 2, 2)
+			f.day, value, ok = atoi(value, o.padded(2), 2)
+		case tokenWeekYear:
+			f.weekYear, value, ok = atoi(value, 4, 4)
+			f.hasWeekYear = true
+		case tokenWeek:
+			f.week, value, ok = atoi(value, o.padded(2), 2)
+		case tokenWeekday:
+			f.weekday, value, ok = atoi(value, 1, 1)
+			ok = ok && f.weekday >= 1 && f.weekday <= 7
+		case tokenEraShortName:
+			f.era, value, ok = searchName(names.eraShort, value)
+			f.hasEra = true
+		case tokenEraLongName:
+			f.era, value, ok = searchName(names.eraLong, value)
+			f.hasEra = true
+		case tokenEraYear:
+			if strings.HasPrefix(value, "元") { // The first year of an era in Japanese.
+				f.eraYear, value, ok = 1, value[len("元"):], true
+			} else {
+				f.eraYear, value, ok = o.atoiUnpadded(value, 4)
+			}
+		case tokenEraYearTwoDigit:
+			f.eraYear, value, ok = atoi(value, o.padded(2), 2)
 		}
 
 		if !ok {
-			return Date{}, &ParseError{Layout: originLayout, Value: originValue, LayoutElem: layoutElem, ValueElem: valueElem}
+			return dateFields{}, "", "", &ParseError{Layout: originLayout, Value: originValue, LayoutElem: layoutElem, ValueElem: valueElem, Offset: len(originValue) - len(valueElem)}
+		}
+
+		f.elems |= dateTokenElem(token)
+		if kind := dateTokenKind(token); kind != KindSyntax {
+			f.spans[kind] = elemSpan{layoutElem: layoutElem, valueElem: valueElem[:len(valueElem)-len(value)], offset: len(originValue) - len(valueElem)}
 		}
 	}
 
-	return NewDate(year, month, day)
+	return f, value, layout, nil
 }
 
 func (d Date) appendRFC3339(b []byte) []byte {
@@ -198,7 +476,7 @@ func (d Date) appendRFC3339(b []byte) []byte {
 func (d Date) appendStrictRFC3339(b []byte) ([]byte, error) {
 	year, month, day := ordinalToCalendar(d.ordinal)
 	if year < 0 || year > 9999 {
-		return nil, errors.New("year is out of range [0,9999]")
+		return nil, rangeError(KindYearOutOfRange, year, 0, 9999)
 	}
 
 	b = appendInt(b, year, 4)
@@ -210,11 +488,15 @@ func (d Date) appendStrictRFC3339(b []byte) ([]byte, error) {
 }
 
 func (d Date) format(layout string) string {
-	year, month, day := ordinalToCalendar(d.ordinal)
 	bytes := make([]byte, 0, len(layout)+10)
+	bytes = appendDate(bytes, layout, elemYear|elemMonth|elemDay|elemWeek, gregorianMonthNames, d.fields(isoWeekScheme))
+	return string(bytes)
+}
 
+// appendDate appends the elements of date formatted by layout, the tokens of elements not in elems are appended as literal.
+func appendDate(bytes []byte, layout string, elems int, names dateNames, f dateFields) []byte {
 	for {
-		prefix, token, suffix := nextDateToken(layout)
+		prefix, token, suffix := nextDateElemToken(layout, elems)
 		bytes = append(bytes, prefix...)
 		if token == 0 {
 			break
@@ -222,27 +504,45 @@ func (d Date) format(layout string) string {
 
 		layout = suffix
 
+		if dateTokenElem(token) == elemWeek {
+			f.weekFields()
+		}
+
 		switch token {
 		case tokenYearTwoDigit:
-			bytes = appendInt(bytes, year%100, 2)
+			bytes = appendInt(bytes, f.year%100, 2)
 		case tokenYearFourDigit:
-			bytes = appendInt(bytes, year, 4)
+			bytes = appendInt(bytes, f.year, 4)
 		case tokenMonth:
-			bytes = appendInt(bytes, month, 0)
+			bytes = appendInt(bytes, f.month, 0)
 		case tokenMonthTwoDigit:
-			bytes = appendInt(bytes, month, 2)
+			bytes = appendInt(bytes, f.month, 2)
 		case tokenMonthShortName:
-			bytes = append(bytes, monthShortNames[month-1]...)
+			bytes = append(bytes, names.monthName(f.year, f.month, true)...)
 		case tokenMonthLongName:
-			bytes = append(bytes, monthLongNames[month-1]...)
+			bytes = append(bytes, names.monthName(f.year, f.month, false)...)
 		case tokenDayOfMonth:
-			bytes = appendInt(bytes, day, 0)
+			bytes = appendInt(bytes, f.day, 0)
 		case tokenDayOfMonthTwoDigit:
-			bytes = appendInt(bytes, day, 2)
+			bytes = appendInt(bytes, f.day, 2)
+		case tokenWeekYear:
+			bytes = appendInt(bytes, f.weekYear, 4)
+		case tokenWeek:
+			bytes = appendInt(bytes, f.week, 2)
+		case tokenWeekday:
+			bytes = appendInt(bytes, f.weekday, 0)
+		case tokenEraShortName:
+			bytes = append(bytes, names.eraShort[f.era]...)
+		case tokenEraLongName:
+			bytes = append(bytes, names.eraLong[f.era]...)
+		case tokenEraYear:
+			bytes = appendInt(bytes, f.eraYear, 0)
+		case tokenEraYearTwoDigit:
+			bytes = appendInt(bytes, f.eraYear, 2)
 		}
 	}
 
-	return string(bytes)
+	return bytes
 }
 
 // Format returns a textual representation of the date.
@@ -255,6 +555,11 @@ func (d Date) format(layout string) string {
 //	MMMM  January-December  The full month name
 //	D      1-31             Day of month
 //	DD    01-31             Day of month, 2-digits
+//	IYYY   2001             Four-digit ISO 8601 week-based year
+//	IW    01-53             ISO 8601 week, 2-digits
+//	ID      1-7             ISO 8601 day of week, beginning at 1 on Monday
+//
+// The letter I is a literal unless it starts IYYY, IW or ID.
 func (d Date) Format(layout string) string {
 	switch layout {
 	case RFC3339Date:
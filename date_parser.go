@@ -0,0 +1,274 @@
+package timex
+
+import (
+	"errors"
+	"strings"
+	"time"
+)
+
+// DateOrder is the order of month and day in numeric dates, such as 03/04/2024.
+type DateOrder int
+
+const (
+	// DateOrderUnknown is the unknown order, the numeric dates cannot be parsed if both month and day are 12 or less.
+	DateOrderUnknown DateOrder = iota
+	// DateOrderMDY is month before day, such as 03/04/2024 is March 4 in United States.
+	DateOrderMDY
+	// DateOrderDMY is day before month, such as 03/04/2024 is April 3 in Europe.
+	DateOrderDMY
+)
+
+// dateLayout is a layout with the length and separators of values in it,
+// which filter out the layouts that cannot parse a value before parsing.
+type dateLayout struct {
+	layout   string
+	min, max int    // min and max are the length of values.
+	seps     string // seps is the separators, which are the bytes other than ASCII letters and digits.
+}
+
+func newDateLayout(layout string) dateLayout {
+	l := dateLayout{layout: layout}
+	var seps []byte
+	appendLiteral := func(s string) {
+		l.min += len(s)
+		l.max += len(s)
+		seps = appendSeparators(seps, s)
+	}
+
+	for {
+		prefix, token, suffix := nextDateElemToken(layout, elemYear|elemMonth|elemDay|elemWeek)
+		appendLiteral(prefix)
+		if token == 0 {
+			break
+		}
+		layout = suffix
+
+		switch token {
+		case tokenYearTwoDigit, tokenMonthTwoDigit, tokenDayOfMonthTwoDigit, tokenWeek:
+			l.min, l.max = l.min+2, l.max+2
+		case tokenYearFourDigit, tokenWeekYear:
+			l.min, l.max = l.min+4, l.max+4
+		case tokenMonth, tokenDayOfMonth:
+			l.min, l.max = l.min+1, l.max+2
+		case tokenWeekday:
+			l.min, l.max = l.min+1, l.max+1
+		case tokenMonthShortName:
+			min, max := nameLengths(monthShortNames)
+			l.min, l.max = l.min+min, l.max+max
+		case tokenMonthLongName:
+			min, max := nameLengths(monthLongNames)
+			l.min, l.max = l.min+min, l.max+max
+		}
+	}
+
+	l.seps = string(seps)
+	return l
+}
+
+// match reports whether the value may be parsed in the layout.
+func (l dateLayout) match(value, seps string) bool {
+	return len(value) >= l.min && len(value) <= l.max && seps == l.seps
+}
+
+// appendSeparators appends the bytes of s other than ASCII letters and digits.
+func appendSeparators(seps []byte, s string) []byte {
+	for i := 0; i < len(s); i++ {
+		if isSeparator(s[i]) || s[i] >= 0x80 {
+			seps = append(seps, s[i])
+		}
+	}
+	return seps
+}
+
+func nameLengths(names []string) (min, max int) {
+	min = len(names[0])
+	for _, name := range names {
+		if len(name) < min {
+			min = len(name)
+		}
+		if len(name) > max {
+			max = len(name)
+		}
+	}
+	return min, max
+}
+
+// detectedDateLayouts is the layouts of dates other than the numeric dates in which the year is last.
+var detectedDateLayouts = newDateLayouts(
+	"YYYY-M-D",
+	"YYYYMMDD",
+	"YYYY/M/D",
+	"YYYY.M.D",
+	"IYYY-WIW-ID",
+	"IYYYWIWID",
+	"IYYY-WIW",
+	"D MMM YYYY",
+	"D MMMM YYYY",
+	"D-MMM-YYYY",
+	"MMM D, YYYY",
+	"MMMM D, YYYY",
+	"MMM D YYYY",
+	"MMMM D YYYY",
+)
+
+func newDateLayouts(layouts ...string) []dateLayout {
+	l := make([]dateLayout, len(layouts))
+	for i, layout := range layouts {
+		l[i] = newDateLayout(layout)
+	}
+	return l
+}
+
+// DateParser parses dates in a prioritized list of layouts, or detects the layouts if the list is empty.
+// The value is parsed in the first layout which matches the whole value,
+// and the layouts which cannot match the value are skipped by the length and separators before parsing.
+//
+// The zero value of DateParser detects the layouts of the following dates:
+//
+//	2006-01-02  2006-1-2  2006/01/02  2006.01.02  20060102   ISO 8601 and the similar dates
+//	2006-W01-1  2006W011  2006-W01                           ISO 8601 week dates
+//	02 Jan 2006  Mon, 02 Jan 2006  2-Jan-2006                RFC 2822 dates and the similar dates
+//	Jan 2, 2006  January 2, 2006  Jan 2 2006
+//	01/02/2006  1/2/06  01-02-2006  01.02.2006               Numeric dates in the order of Order
+type DateParser struct {
+	// Order is the order of month and day of the detected numeric dates, in which the year is last.
+	// If month and day are both 12 or less and are not equal, the date is ambiguous, and it is parsed
+	// in the order, or an error is returned if the order is unknown.
+	Order DateOrder
+
+	layouts []dateLayout
+}
+
+// NewDateParser returns a parser of dates in the layouts, which use the tokens of ParseDate.
+// The layouts are tried in order, and they are detected if none is given.
+func NewDateParser(layouts ...string) DateParser {
+	return DateParser{layouts: newDateLayouts(layouts...)}
+}
+
+// ParseDateAny parses a formatted string in the layouts in order and returns the date it represents,
+// the layouts are detected if none is given, see DateParser.
+func ParseDateAny(value string, layouts ...string) (Date, error) {
+	return NewDateParser(layouts...).Parse(value)
+}
+
+// Parse parses a formatted string and returns the date it represents, the leading and trailing spaces are ignored.
+// If no layout can parse the value, the error of the first layout which matches the length and separators is returned.
+func (p DateParser) Parse(value string) (Date, error) {
+	value = strings.TrimSpace(value)
+	if len(p.layouts) == 0 {
+		return p.detect(value)
+	}
+	return parseDateLayouts(value, p.layouts)
+}
+
+func parseDateLayouts(value string, layouts []dateLayout) (Date, error) {
+	seps := string(appendSeparators(nil, value))
+
+	var firstErr error
+	for _, l := range layouts {
+		if !l.match(value, seps) {
+			continue
+		}
+
+		f, err := parseDateExact(l.layout, value, elemYear|elemMonth|elemDay|elemWeek, gregorianMonthNames)
+		if err == nil {
+			var date Date
+			if date, err = f.date(isoWeekScheme); err == nil {
+				return date, nil
+			}
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+
+	if firstErr == nil {
+		return Date{}, &ParseError{Value: value, Err: errors.New("no layout matches")}
+	}
+	return Date{}, firstErr
+}
+
+// detect parses the value in the detected layouts.
+func (p DateParser) detect(value string) (Date, error) {
+	if weekday, rest, ok := cutWeekdayName(value); ok {
+		date, err := p.detect(rest)
+		if err == nil && date.Weekday() != weekday {
+			return Date{}, &ParseError{Value: value, Err: errors.New("weekday does not match the date")}
+		}
+		return date, err
+	}
+
+	layout, err := p.numericLayout(value)
+	if err != nil {
+		return Date{}, err
+	}
+	if layout != "" {
+		return parseDateLayouts(value, []dateLayout{newDateLayout(layout)})
+	}
+
+	return parseDateLayouts(value, detectedDateLayouts)
+}
+
+// numericLayout returns the layout of the numeric date in which the year is last, such as M/D/YYYY.
+// It returns an empty layout if value is not such a date.
+func (p DateParser) numericLayout(value string) (string, error) {
+	i := strings.IndexAny(value, "/.-")
+	if i < 0 {
+		return "", nil
+	}
+	sep := value[i : i+1]
+
+	parts := strings.Split(value, sep)
+	if len(parts) != 3 || len(parts[0]) > 2 || len(parts[1]) > 2 || len(parts[2]) != 2 && len(parts[2]) != 4 {
+		return "", nil
+	}
+	var n [2]int
+	for i, part := range parts {
+		v, rest, ok := atoi(part, 1, 4)
+		if !ok || rest != "" {
+			return "", nil
+		}
+		if i < 2 {
+			n[i] = v
+		}
+	}
+
+	year := "YYYY"
+	if len(parts[2]) == 2 {
+		year = "YY"
+	}
+
+	order := p.Order
+	switch {
+	case n[0] > 12:
+		order = DateOrderDMY
+	case n[1] > 12 || n[0] == n[1]:
+		order = DateOrderMDY
+	}
+
+	switch order {
+	case DateOrderMDY:
+		return "M" + sep + "D" + sep + year, nil
+	case DateOrderDMY:
+		return "D" + sep + "M" + sep + year, nil
+	default:
+		return "", &ParseError{Value: value, Kind: KindAmbiguous, Err: errors.New("order of month and day is ambiguous")}
+	}
+}
+
+// cutWeekdayName cuts the English weekday name followed by a comma at the beginning of value, such as "Mon, ".
+func cutWeekdayName(value string) (time.Weekday, string, bool) {
+	i := strings.Index(value, ",")
+	if i < 0 {
+		return 0, value, false
+	}
+
+	name := value[:i]
+	for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+		long := weekday.String()
+		if len(name) == len(long) && match(name, long) || len(name) == 3 && match(name, long[:3]) {
+			return weekday, strings.TrimLeft(value[i+1:], " "), true
+		}
+	}
+	return 0, value, false
+}
@@ -0,0 +1,115 @@
+package timex_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/invzhi/timex"
+)
+
+func TestParseDateAny(t *testing.T) {
+	layouts := []string{"YYYY-MM-DD", "DD.MM.YYYY", "MMMM D, YYYY", "YYYYMMDD"}
+
+	tests := []struct {
+		value string
+		date  timex.Date
+	}{
+		{"2024-03-05", timex.MustNewDate(2024, 3, 5)},
+		{" 05.03.2024 ", timex.MustNewDate(2024, 3, 5)},
+		{"March 5, 2024", timex.MustNewDate(2024, 3, 5)},
+		{"20240305", timex.MustNewDate(2024, 3, 5)},
+	}
+
+	for _, tt := range tests {
+		date, err := timex.ParseDateAny(tt.value, layouts...)
+		assert.NoError(t, err, tt.value)
+		assert.Equal(t, tt.date, date, tt.value)
+	}
+
+	tests2 := []struct {
+		value string
+		err   string
+	}{
+		{"2024/03/05", `parsing "2024/03/05": no layout matches`},
+		{"2024-02-30", `parsing "2024-02-30" as "YYYY-MM-DD": day is out of range [1,29]`},
+		{"2024-03-05x", `parsing "2024-03-05x": no layout matches`},
+		{"March 5, 24", `parsing "March 5, 24" as "MMMM D, YYYY": cannot parse "24" as "YYYY"`},
+		{"Marc 5, 2024", `parsing "Marc 5, 2024" as "MMMM D, YYYY": cannot parse "Marc 5, 2024" as "MMMM"`},
+	}
+
+	for _, tt := range tests2 {
+		_, err := timex.ParseDateAny(tt.value, layouts...)
+		assert.EqualError(t, err, tt.err, tt.value)
+	}
+
+	// The first layout which parses the whole value wins.
+	date, err := timex.ParseDateAny("01/02/2024", "M/D/YY", "D/M/YYYY", "M/D/YYYY")
+	assert.NoError(t, err)
+	assert.Equal(t, timex.MustNewDate(2024, 2, 1), date)
+}
+
+func TestDateParser_Detect(t *testing.T) {
+	tests := []struct {
+		value string
+		date  timex.Date
+	}{
+		{"2024-03-05", timex.MustNewDate(2024, 3, 5)},
+		{"2024-3-5", timex.MustNewDate(2024, 3, 5)},
+		{"2024/03/05", timex.MustNewDate(2024, 3, 5)},
+		{"20240305", timex.MustNewDate(2024, 3, 5)},
+		{"2024-W10-2", timex.MustNewDate(2024, 3, 5)},
+		{"2024W102", timex.MustNewDate(2024, 3, 5)},
+		{"2024-W10", timex.MustNewDate(2024, 3, 4)},
+		{"05 Mar 2024", timex.MustNewDate(2024, 3, 5)},
+		{"Tue, 05 Mar 2024", timex.MustNewDate(2024, 3, 5)},
+		{"tuesday, 5 march 2024", timex.MustNewDate(2024, 3, 5)},
+		{"5-MAR-2024", timex.MustNewDate(2024, 3, 5)},
+		{"Mar 5, 2024", timex.MustNewDate(2024, 3, 5)},
+		{"September 30 2024", timex.MustNewDate(2024, 9, 30)},
+		{"13/03/2024", timex.MustNewDate(2024, 3, 13)},
+		{"03/13/2024", timex.MustNewDate(2024, 3, 13)},
+		{"3.3.24", timex.MustNewDate(2024, 3, 3)},
+	}
+
+	for _, tt := range tests {
+		date, err := timex.DateParser{}.Parse(tt.value)
+		assert.NoError(t, err, tt.value)
+		assert.Equal(t, tt.date, date, tt.value)
+	}
+
+	t.Run("Order", func(t *testing.T) {
+		_, err := timex.DateParser{}.Parse("03/05/2024")
+		assert.EqualError(t, err, `parsing "03/05/2024": order of month and day is ambiguous`)
+
+		date, err := timex.DateParser{Order: timex.DateOrderMDY}.Parse("03/05/2024")
+		assert.NoError(t, err)
+		assert.Equal(t, timex.MustNewDate(2024, 3, 5), date)
+
+		date, err = timex.DateParser{Order: timex.DateOrderDMY}.Parse("03-05-2024")
+		assert.NoError(t, err)
+		assert.Equal(t, timex.MustNewDate(2024, 5, 3), date)
+
+		date, err = timex.DateParser{Order: timex.DateOrderMDY}.Parse("13/05/2024")
+		assert.NoError(t, err)
+		assert.Equal(t, timex.MustNewDate(2024, 5, 13), date)
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		tests := []struct {
+			value string
+			err   string
+		}{
+			{"", `parsing "": no layout matches`},
+			{"next friday", `parsing "next friday": no layout matches`},
+			{"Mon, 05 Mar 2024", `parsing "Mon, 05 Mar 2024": weekday does not match the date`},
+			{"13/13/2024", `parsing "13/13/2024" as "D/M/YYYY": month is out of range [1,12]`},
+			{"2024-02-30", `parsing "2024-02-30" as "YYYY-M-D": day is out of range [1,29]`},
+		}
+
+		for _, tt := range tests {
+			_, err := timex.DateParser{}.Parse(tt.value)
+			assert.EqualError(t, err, tt.err, tt.value)
+		}
+	})
+}
@@ -508,6 +508,202 @@ func TestDateQuarter(t *testing.T) {
 	}
 }
 
+func TestDateDaysIn(t *testing.T) {
+	tests := []struct {
+		year, month int
+		isLeap      bool
+		daysInMonth int
+		daysInYear  int
+	}{
+		{1900, 2, false, 28, 365},
+		{2000, 2, true, 29, 366},
+		{2001, 2, false, 28, 365},
+		{2004, 2, true, 29, 366},
+		{2004, 4, true, 30, 366},
+		{2004, 12, true, 31, 366},
+		{0, 2, true, 29, 366},
+		{-1, 2, false, 28, 365},
+	}
+
+	for _, tt := range tests {
+		date := timex.MustNewDate(tt.year, tt.month, 10)
+		assert.Equal(t, tt.isLeap, date.IsLeapYear())
+		assert.Equal(t, tt.daysInMonth, date.DaysInMonth())
+		assert.Equal(t, tt.daysInYear, date.DaysInYear())
+	}
+}
+
+func TestDateStartEndOf(t *testing.T) {
+	tests := []struct {
+		date                         timex.Date
+		startOfMonth, endOfMonth     timex.Date
+		startOfQuarter, endOfQuarter timex.Date
+		startOfYear, endOfYear       timex.Date
+	}{
+		{
+			timex.MustNewDate(2024, 2, 10),
+			timex.MustNewDate(2024, 2, 1), timex.MustNewDate(2024, 2, 29),
+			timex.MustNewDate(2024, 1, 1), timex.MustNewDate(2024, 3, 31),
+			timex.MustNewDate(2024, 1, 1), timex.MustNewDate(2024, 12, 31),
+		},
+		{
+			timex.MustNewDate(2023, 2, 28),
+			timex.MustNewDate(2023, 2, 1), timex.MustNewDate(2023, 2, 28),
+			timex.MustNewDate(2023, 1, 1), timex.MustNewDate(2023, 3, 31),
+			timex.MustNewDate(2023, 1, 1), timex.MustNewDate(2023, 12, 31),
+		},
+		{
+			timex.MustNewDate(2023, 6, 1),
+			timex.MustNewDate(2023, 6, 1), timex.MustNewDate(2023, 6, 30),
+			timex.MustNewDate(2023, 4, 1), timex.MustNewDate(2023, 6, 30),
+			timex.MustNewDate(2023, 1, 1), timex.MustNewDate(2023, 12, 31),
+		},
+		{
+			timex.MustNewDate(2023, 8, 15),
+			timex.MustNewDate(2023, 8, 1), timex.MustNewDate(2023, 8, 31),
+			timex.MustNewDate(2023, 7, 1), timex.MustNewDate(2023, 9, 30),
+			timex.MustNewDate(2023, 1, 1), timex.MustNewDate(2023, 12, 31),
+		},
+		{
+			timex.MustNewDate(2023, 12, 31),
+			timex.MustNewDate(2023, 12, 1), timex.MustNewDate(2023, 12, 31),
+			timex.MustNewDate(2023, 10, 1), timex.MustNewDate(2023, 12, 31),
+			timex.MustNewDate(2023, 1, 1), timex.MustNewDate(2023, 12, 31),
+		},
+		{
+			timex.MustNewDate(0, 11, 5),
+			timex.MustNewDate(0, 11, 1), timex.MustNewDate(0, 11, 30),
+			timex.MustNewDate(0, 10, 1), timex.MustNewDate(0, 12, 31),
+			timex.MustNewDate(0, 1, 1), timex.MustNewDate(0, 12, 31),
+		},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.startOfMonth, tt.date.StartOfMonth())
+		assert.Equal(t, tt.endOfMonth, tt.date.EndOfMonth())
+		assert.Equal(t, tt.startOfQuarter, tt.date.StartOfQuarter())
+		assert.Equal(t, tt.endOfQuarter, tt.date.EndOfQuarter())
+		assert.Equal(t, tt.startOfYear, tt.date.StartOfYear())
+		assert.Equal(t, tt.endOfYear, tt.date.EndOfYear())
+	}
+
+	t.Run("Week", func(t *testing.T) {
+		// 2024-01-10 is wednesday.
+		date := timex.MustNewDate(2024, 1, 10)
+		assert.Equal(t, timex.MustNewDate(2024, 1, 8), date.StartOfISOWeek())
+		assert.Equal(t, timex.MustNewDate(2024, 1, 8), date.StartOfWeek(time.Monday))
+		assert.Equal(t, timex.MustNewDate(2024, 1, 7), date.StartOfWeek(time.Sunday))
+		assert.Equal(t, timex.MustNewDate(2024, 1, 6), date.StartOfWeek(time.Saturday))
+		assert.Equal(t, timex.MustNewDate(2024, 1, 10), date.StartOfWeek(time.Wednesday))
+		assert.Equal(t, timex.MustNewDate(2024, 1, 4), date.StartOfWeek(time.Thursday))
+
+		for n := -14; n <= 14; n++ {
+			d := date.AddDays(n)
+			for weekday := time.Sunday; weekday <= time.Saturday; weekday++ {
+				start := d.StartOfWeek(weekday)
+				assert.Equal(t, weekday, start.Weekday())
+				assert.True(t, d.Sub(start) >= 0 && d.Sub(start) < 7)
+			}
+		}
+	})
+}
+
+func TestNthWeekdayOfMonth(t *testing.T) {
+	tests := []struct {
+		year, month, n int
+		weekday        time.Weekday
+		date           timex.Date
+	}{
+		{2024, 5, 1, time.Monday, timex.MustNewDate(2024, 5, 6)},
+		{2024, 5, -1, time.Monday, timex.MustNewDate(2024, 5, 27)},
+		{2024, 5, 5, time.Friday, timex.MustNewDate(2024, 5, 31)},
+		{2024, 5, -1, time.Friday, timex.MustNewDate(2024, 5, 31)},
+		{2024, 5, -5, time.Friday, timex.MustNewDate(2024, 5, 3)},
+		{2024, 11, 4, time.Thursday, timex.MustNewDate(2024, 11, 28)},
+		{2024, 2, 5, time.Thursday, timex.MustNewDate(2024, 2, 29)},
+		{2023, 2, -1, time.Tuesday, timex.MustNewDate(2023, 2, 28)},
+		{2023, 2, 1, time.Wednesday, timex.MustNewDate(2023, 2, 1)},
+	}
+
+	for _, tt := range tests {
+		date := timex.MustNthWeekdayOfMonth(tt.year, tt.month, tt.n, tt.weekday)
+		assert.Equal(t, tt.date, date)
+		assert.Equal(t, tt.weekday, date.Weekday())
+
+		if tt.n > 0 {
+			assert.Equal(t, tt.n, date.WeekdayOccurrence())
+		}
+	}
+
+	t.Run("Last", func(t *testing.T) {
+		date, err := timex.LastWeekdayOfMonth(2024, 3, time.Friday)
+		assert.NoError(t, err)
+		assert.Equal(t, timex.MustNewDate(2024, 3, 29), date)
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		errTests := []struct {
+			year, month, n int
+			weekday        time.Weekday
+			errString      string
+		}{
+			{2024, 13, 1, time.Monday, "month is out of range [1,12]"},
+			{2024, 5, 0, time.Monday, "n is out of range [1,4]"},
+			{2024, 5, 5, time.Monday, "n is out of range [1,4]"},
+			{2024, 5, -5, time.Monday, "n is out of range [-4,-1]"},
+			{2024, 5, 6, time.Friday, "n is out of range [1,5]"},
+			{2024, 5, 0, time.Friday, "n is out of range [1,5]"},
+			{2024, 5, -6, time.Friday, "n is out of range [-5,-1]"},
+			{2024, 2, 6, time.Thursday, "n is out of range [1,5]"},
+			{2024, 2, -6, time.Thursday, "n is out of range [-5,-1]"},
+			{2024, 5, 100, time.Monday, "n is out of range [1,4]"},
+			{2024, 5, -100, time.Monday, "n is out of range [-4,-1]"},
+		}
+
+		for _, tt := range errTests {
+			_, err := timex.NthWeekdayOfMonth(tt.year, tt.month, tt.n, tt.weekday)
+			assert.EqualError(t, err, tt.errString)
+
+			assert.Panicsf(t, func() {
+				_ = timex.MustNthWeekdayOfMonth(tt.year, tt.month, tt.n, tt.weekday)
+			}, "timex: NthWeekdayOfMonth: "+tt.errString)
+		}
+
+		_, err := timex.NthWeekdayOfMonth(2024, 5, -6, time.Friday)
+		var e *timex.RangeError
+		if assert.ErrorAs(t, err, &e) {
+			assert.Equal(t, timex.KindWeekOutOfRange, e.Kind)
+			assert.Equal(t, [3]int{-6, -5, -1}, [3]int{e.Value, e.Min, e.Max})
+		}
+		assert.ErrorIs(t, err, timex.ErrWeekOutOfRange)
+	})
+}
+
+func TestDateNextPrevious(t *testing.T) {
+	// 2024-01-10 is wednesday.
+	date := timex.MustNewDate(2024, 1, 10)
+
+	tests := []struct {
+		weekday                  time.Weekday
+		next, nextOrSame         timex.Date
+		previous, previousOrSame timex.Date
+	}{
+		{time.Wednesday, timex.MustNewDate(2024, 1, 17), date, timex.MustNewDate(2024, 1, 3), date},
+		{time.Thursday, timex.MustNewDate(2024, 1, 11), timex.MustNewDate(2024, 1, 11), timex.MustNewDate(2024, 1, 4), timex.MustNewDate(2024, 1, 4)},
+		{time.Tuesday, timex.MustNewDate(2024, 1, 16), timex.MustNewDate(2024, 1, 16), timex.MustNewDate(2024, 1, 9), timex.MustNewDate(2024, 1, 9)},
+		{time.Sunday, timex.MustNewDate(2024, 1, 14), timex.MustNewDate(2024, 1, 14), timex.MustNewDate(2024, 1, 7), timex.MustNewDate(2024, 1, 7)},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.next, date.Next(tt.weekday))
+		assert.Equal(t, tt.nextOrSame, date.NextOrSame(tt.weekday))
+		assert.Equal(t, tt.previous, date.Previous(tt.weekday))
+		assert.Equal(t, tt.previousOrSame, date.PreviousOrSame(tt.weekday))
+	}
+
+	assert.Equal(t, 2, date.WeekdayOccurrence())
+}
+
 func TestDateAdd(t *testing.T) {
 	tests := []struct {
 		years, months, days int
@@ -0,0 +1,64 @@
+package timex
+
+// The ordinals of the epochs of day numbers, which are days since January 1 of year 1.
+const (
+	julianDayNumberEpoch = -1721426 // November 24, 4714 BC in proleptic Gregorian calendar, which is day 0.
+	modifiedJulianEpoch  = 678575   // November 17, 1858, which is day 0.
+	rataDieEpoch         = -1       // December 31 of year 0, which is day 0.
+	lilianEpoch          = 577734   // October 14, 1582, which is day 0.
+	unixEpoch            = 719162   // January 1, 1970, which is day 0.
+)
+
+// DateFromJulianDayNumber returns the date corresponding to the Julian Day Number,
+// which is the day number of the julian day beginning at noon of the date.
+func DateFromJulianDayNumber(n int) Date {
+	return Date{ordinal: n + julianDayNumberEpoch}
+}
+
+// JulianDayNumber returns the Julian Day Number of the julian day beginning at noon of the date d.
+func (d Date) JulianDayNumber() int {
+	return d.ordinal - julianDayNumberEpoch
+}
+
+// DateFromModifiedJulianDate returns the date corresponding to the Modified Julian Date,
+// which is days since November 17, 1858.
+func DateFromModifiedJulianDate(n int) Date {
+	return Date{ordinal: n + modifiedJulianEpoch}
+}
+
+// ModifiedJulianDate returns the Modified Julian Date of the date d, which is days since November 17, 1858.
+func (d Date) ModifiedJulianDate() int {
+	return d.ordinal - modifiedJulianEpoch
+}
+
+// DateFromRataDie returns the date corresponding to the Rata Die, January 1 of year 1 is day 1.
+func DateFromRataDie(n int) Date {
+	return Date{ordinal: n + rataDieEpoch}
+}
+
+// RataDie returns the Rata Die of the date d, January 1 of year 1 is day 1.
+func (d Date) RataDie() int {
+	return d.ordinal - rataDieEpoch
+}
+
+// DateFromLilianDayNumber returns the date corresponding to the Lilian day number,
+// October 15, 1582, the first day of Gregorian calendar, is day 1.
+func DateFromLilianDayNumber(n int) Date {
+	return Date{ordinal: n + lilianEpoch}
+}
+
+// LilianDayNumber returns the Lilian day number of the date d,
+// October 15, 1582, the first day of Gregorian calendar, is day 1.
+func (d Date) LilianDayNumber() int {
+	return d.ordinal - lilianEpoch
+}
+
+// DateFromUnixDays returns the date corresponding to days since January 1, 1970.
+func DateFromUnixDays(n int) Date {
+	return Date{ordinal: n + unixEpoch}
+}
+
+// UnixDays returns days since January 1, 1970 of the date d.
+func (d Date) UnixDays() int {
+	return d.ordinal - unixEpoch
+}
@@ -0,0 +1,50 @@
+package timex_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/invzhi/timex"
+)
+
+func TestDayNumber(t *testing.T) {
+	tests := []struct {
+		date   timex.Date
+		jdn    int
+		mjd    int
+		rd     int
+		lilian int
+		unix   int
+	}{
+		{timex.MustNewDate(-4713, 11, 24), 0, -2400001, -1721425, -2299160, -2440588},
+		{timex.MustNewDate(1, 1, 1), 1721426, -678575, 1, -577734, -719162},
+		{timex.MustNewDate(1582, 10, 15), 2299161, -100840, 577736, 1, -141427},
+		{timex.MustNewDate(1858, 11, 17), 2400001, 0, 678576, 100841, -40587},
+		{timex.MustNewDate(1970, 1, 1), 2440588, 40587, 719163, 141428, 0},
+		{timex.MustNewDate(2000, 1, 1), 2451545, 51544, 730120, 152385, 10957},
+		{timex.MustNewDate(2024, 2, 29), 2460370, 60369, 738945, 161210, 19782},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.jdn, tt.date.JulianDayNumber())
+		assert.Equal(t, tt.mjd, tt.date.ModifiedJulianDate())
+		assert.Equal(t, tt.rd, tt.date.RataDie())
+		assert.Equal(t, tt.lilian, tt.date.LilianDayNumber())
+		assert.Equal(t, tt.unix, tt.date.UnixDays())
+
+		assert.Equal(t, tt.date, timex.DateFromJulianDayNumber(tt.jdn))
+		assert.Equal(t, tt.date, timex.DateFromModifiedJulianDate(tt.mjd))
+		assert.Equal(t, tt.date, timex.DateFromRataDie(tt.rd))
+		assert.Equal(t, tt.date, timex.DateFromLilianDayNumber(tt.lilian))
+		assert.Equal(t, tt.date, timex.DateFromUnixDays(tt.unix))
+	}
+
+	t.Run("Unix", func(t *testing.T) {
+		for n := -800000; n < 800000; n += 997 {
+			tm := time.Unix(int64(n)*86400, 0).UTC()
+			assert.Equal(t, timex.DateFromTime(tm), timex.DateFromUnixDays(n))
+		}
+	})
+}
@@ -0,0 +1,196 @@
+package timex
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Era is a named era which numbers years of Gregorian calendar from the year of its first day,
+// which is year 1 of the era.
+type Era struct {
+	// Name is the full name of the era, such as 令和.
+	Name string
+	// Abbr is the abbreviated name of the era, such as R.
+	Abbr string
+	// Start is the first day of the era.
+	Start Date
+}
+
+// EraCalendar is Gregorian calendar with years numbered in eras.
+type EraCalendar struct {
+	// Eras is the eras sorted by the first day, an era ends on the day before the next era starts.
+	Eras []Era
+	// BeforeEra is the name of years before the first era, which are numbered backwards from
+	// the year before the first era. If it is empty, the dates before the first era are invalid.
+	BeforeEra string
+}
+
+var (
+	// JapaneseCalendar is the Japanese imperial eras since Meiji.
+	// The dates before January 1, 1873 are in proleptic Gregorian calendar.
+	JapaneseCalendar = EraCalendar{
+		Eras: []Era{
+			{Name: "明治", Abbr: "M", Start: Date{ordinal: 682203}}, // October 23, 1868.
+			{Name: "大正", Abbr: "T", Start: Date{ordinal: 698188}}, // July 30, 1912.
+			{Name: "昭和", Abbr: "S", Start: Date{ordinal: 703449}}, // December 25, 1926.
+			{Name: "平成", Abbr: "H", Start: Date{ordinal: 726109}}, // January 8, 1989.
+			{Name: "令和", Abbr: "R", Start: Date{ordinal: 737179}}, // May 1, 2019.
+		},
+	}
+	// MinguoCalendar is the Republic of China calendar, year 1 is 1912, and the years before are 民國前.
+	MinguoCalendar = EraCalendar{
+		Eras:      []Era{{Name: "民國", Abbr: "民國", Start: Date{ordinal: 697977}}}, // January 1, 1912.
+		BeforeEra: "民國前",
+	}
+	// ThaiBuddhistCalendar is the Thai solar calendar, year 1 of Buddhist Era is 543 BC, so 2024 is 2567.
+	ThaiBuddhistCalendar = EraCalendar{
+		Eras: []Era{{Name: "พุทธศักราช", Abbr: "พ.ศ.", Start: Date{ordinal: -198327}}}, // January 1, 543 BC.
+	}
+)
+
+// AddEra adds the era after the last era, such as a new Japanese era.
+// It is not safe to call AddEra concurrently with other methods, so it is usually called in the initialization.
+func (c *EraCalendar) AddEra(era Era) error {
+	if era.Name == "" {
+		return errors.New("era name is empty")
+	}
+	if n := len(c.Eras); n > 0 && !era.Start.After(c.Eras[n-1].Start) {
+		return errors.New("era does not start after the last era")
+	}
+	// Copy the eras which may be shared with other calendars.
+	c.Eras = append(c.Eras[:len(c.Eras):len(c.Eras)], era)
+	return nil
+}
+
+// eraIndex returns the index of era which the date d is in, it returns -1 if d is before the first era.
+func (c EraCalendar) eraIndex(d Date) int {
+	i := len(c.Eras) - 1
+	for i >= 0 && d.Before(c.Eras[i].Start) {
+		i--
+	}
+	return i
+}
+
+// Era returns the era and the year of era specified by d.
+// The era has only a name if d is before the first era.
+func (c EraCalendar) Era(d Date) (era Era, year int, err error) {
+	i := c.eraIndex(d)
+	if i >= 0 {
+		return c.Eras[i], d.Year() - c.Eras[i].Start.Year() + 1, nil
+	}
+	if c.BeforeEra == "" || len(c.Eras) == 0 {
+		return Era{}, 0, errors.New("date is before the first era")
+	}
+	return Era{Name: c.BeforeEra, Abbr: c.BeforeEra}, c.Eras[0].Start.Year() - d.Year(), nil
+}
+
+// NewDate returns the date corresponding to the era, year of era, month, and day.
+// The era is matched with both the full and abbreviated names, and the date must be in the era.
+func (c EraCalendar) NewDate(era string, year, month, day int) (Date, error) {
+	if era != "" && era == c.BeforeEra && len(c.Eras) > 0 {
+		date, err := NewDate(c.Eras[0].Start.Year()-year, month, day)
+		if err != nil {
+			return Date{}, err
+		}
+		if year < 1 || !date.Before(c.Eras[0].Start) {
+			return Date{}, errors.New("date is out of range of the era")
+		}
+		return date, nil
+	}
+
+	for i, e := range c.Eras {
+		if era != e.Name && era != e.Abbr {
+			continue
+		}
+
+		date, err := NewDate(e.Start.Year()+year-1, month, day)
+		if err != nil {
+			return Date{}, err
+		}
+		if date.Before(e.Start) || i+1 < len(c.Eras) && !date.Before(c.Eras[i+1].Start) {
+			return Date{}, errors.New("date is out of range of the era")
+		}
+		return date, nil
+	}
+	return Date{}, fmt.Errorf("unknown era %q", era)
+}
+
+// MustNewDate is like NewDate but panics if the date cannot be created.
+func (c EraCalendar) MustNewDate(era string, year, month, day int) Date {
+	date, err := c.NewDate(era, year, month, day)
+	if err != nil {
+		panic(`timex: EraCalendar.NewDate: ` + err.Error())
+	}
+	return date
+}
+
+// names returns the names of months and eras, the years before the first era are the last era.
+func (c EraCalendar) names() dateNames {
+	names := gregorianMonthNames
+	for _, era := range c.Eras {
+		names.eraShort = append(names.eraShort, era.Abbr)
+		names.eraLong = append(names.eraLong, era.Name)
+	}
+	if c.BeforeEra != "" {
+		names.eraShort = append(names.eraShort, c.BeforeEra)
+		names.eraLong = append(names.eraLong, c.BeforeEra)
+	}
+	return names
+}
+
+// Parse parses a formatted string and returns the date it represents.
+// The layout uses the tokens of ParseDate, and the following tokens of era:
+//
+//	G       R         The abbreviated era name
+//	GGGG    令和      The full era name
+//	e       1-9999    Year of era, 元 is year 1
+//	ee      01-99     Year of era, 2-digits
+//
+// The era tokens are literals in a word of ASCII letters other than G and e, such as the e in "Week".
+//
+// The year of era takes precedence over the year if both are in the layout.
+// The year of era is in the last era if the layout has no era name.
+func (c EraCalendar) Parse(layout, value string) (Date, error) {
+	names := c.names()
+	f, err := parseDate(layout, value, elemYear|elemMonth|elemDay|elemEra, names)
+	if err != nil {
+		return Date{}, err
+	}
+	switch {
+	case f.elems&elemEra == 0:
+		date, err := NewDate(f.year, f.month, f.day)
+		return date, f.parseError(err)
+	case !f.hasEra && len(c.Eras) == 0:
+		return Date{}, errors.New("era is missing")
+	case !f.hasEra:
+		f.era = len(c.Eras) - 1
+	}
+	date, err := c.NewDate(names.eraLong[f.era], f.eraYear, f.month, f.day)
+	return date, f.parseError(err)
+}
+
+// Format returns a textual representation of the date d.
+// The layout uses the tokens of Date.Format, and the tokens of era described in Parse.
+// If d is before the first era and BeforeEra is empty, the era name is empty and the year of era is 0,
+// which can be checked by Era.
+func (c EraCalendar) Format(d Date, layout string) string {
+	var f dateFields
+	f.year, f.month, f.day = d.Date()
+
+	names := c.names()
+	_, year, err := c.Era(d)
+	switch {
+	case err != nil:
+		names.eraShort = append(names.eraShort, "")
+		names.eraLong = append(names.eraLong, "")
+		f.era = len(names.eraLong) - 1
+	case c.eraIndex(d) < 0:
+		f.era, f.eraYear = len(c.Eras), year
+	default:
+		f.era, f.eraYear = c.eraIndex(d), year
+	}
+
+	bytes := make([]byte, 0, len(layout)+10)
+	bytes = appendDate(bytes, layout, elemYear|elemMonth|elemDay|elemEra, names, f)
+	return string(bytes)
+}
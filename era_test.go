@@ -0,0 +1,160 @@
+package timex_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/invzhi/timex"
+)
+
+func TestEraCalendar(t *testing.T) {
+	tests := []struct {
+		calendar timex.EraCalendar
+		date     timex.Date
+		era      string
+		year     int
+	}{
+		{timex.JapaneseCalendar, timex.MustNewDate(1868, 10, 23), "明治", 1},
+		{timex.JapaneseCalendar, timex.MustNewDate(1912, 7, 29), "明治", 45},
+		{timex.JapaneseCalendar, timex.MustNewDate(1912, 7, 30), "大正", 1},
+		{timex.JapaneseCalendar, timex.MustNewDate(1926, 12, 25), "昭和", 1},
+		{timex.JapaneseCalendar, timex.MustNewDate(1989, 1, 7), "昭和", 64},
+		{timex.JapaneseCalendar, timex.MustNewDate(1989, 1, 8), "平成", 1},
+		{timex.JapaneseCalendar, timex.MustNewDate(2019, 4, 30), "平成", 31},
+		{timex.JapaneseCalendar, timex.MustNewDate(2019, 5, 1), "令和", 1},
+		{timex.JapaneseCalendar, timex.MustNewDate(2024, 3, 5), "令和", 6},
+		{timex.MinguoCalendar, timex.MustNewDate(1912, 1, 1), "民國", 1},
+		{timex.MinguoCalendar, timex.MustNewDate(2024, 3, 5), "民國", 113},
+		{timex.MinguoCalendar, timex.MustNewDate(1911, 12, 31), "民國前", 1},
+		{timex.MinguoCalendar, timex.MustNewDate(1900, 1, 1), "民國前", 12},
+		{timex.ThaiBuddhistCalendar, timex.MustNewDate(2024, 3, 5), "พุทธศักราช", 2567},
+		{timex.ThaiBuddhistCalendar, timex.MustNewDate(-542, 1, 1), "พุทธศักราช", 1},
+	}
+
+	for _, tt := range tests {
+		era, year, err := tt.calendar.Era(tt.date)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.era, era.Name)
+		assert.Equal(t, tt.year, year)
+
+		date, err := tt.calendar.NewDate(tt.era, tt.year, tt.date.Month(), tt.date.Day())
+		assert.NoError(t, err)
+		assert.Equal(t, tt.date, date)
+	}
+
+	t.Run("Errors", func(t *testing.T) {
+		_, _, err := timex.JapaneseCalendar.Era(timex.MustNewDate(1868, 10, 22))
+		assert.EqualError(t, err, "date is before the first era")
+		_, _, err = timex.ThaiBuddhistCalendar.Era(timex.MustNewDate(-543, 12, 31))
+		assert.EqualError(t, err, "date is before the first era")
+
+		tests := []struct {
+			calendar         timex.EraCalendar
+			era              string
+			year, month, day int
+			err              string
+		}{
+			{timex.JapaneseCalendar, "平成", 31, 5, 1, "date is out of range of the era"},
+			{timex.JapaneseCalendar, "令和", 1, 4, 30, "date is out of range of the era"},
+			{timex.JapaneseCalendar, "令和", 6, 2, 30, "day is out of range [1,29]"},
+			{timex.JapaneseCalendar, "天保", 1, 1, 1, `unknown era "天保"`},
+			{timex.MinguoCalendar, "民國", 0, 12, 31, "date is out of range of the era"},
+			{timex.MinguoCalendar, "民國前", 0, 1, 1, "date is out of range of the era"},
+		}
+
+		for _, tt := range tests {
+			_, err := tt.calendar.NewDate(tt.era, tt.year, tt.month, tt.day)
+			assert.EqualError(t, err, tt.err)
+		}
+
+		assert.PanicsWithValue(t, "timex: EraCalendar.NewDate: date is out of range of the era", func() {
+			timex.JapaneseCalendar.MustNewDate("R", 1, 1, 1)
+		})
+	})
+
+	t.Run("AddEra", func(t *testing.T) {
+		calendar := timex.JapaneseCalendar
+		assert.NoError(t, calendar.AddEra(timex.Era{Name: "新元", Abbr: "N", Start: timex.MustNewDate(2100, 1, 1)}))
+		assert.Len(t, timex.JapaneseCalendar.Eras, 5)
+
+		era, year, err := calendar.Era(timex.MustNewDate(2099, 12, 31))
+		assert.NoError(t, err)
+		assert.Equal(t, "令和", era.Name)
+		assert.Equal(t, 81, year)
+
+		era, year, err = calendar.Era(timex.MustNewDate(2100, 1, 1))
+		assert.NoError(t, err)
+		assert.Equal(t, "新元", era.Name)
+		assert.Equal(t, 1, year)
+
+		assert.Equal(t, timex.MustNewDate(2101, 2, 3), calendar.MustNewDate("N", 2, 2, 3))
+
+		err = calendar.AddEra(timex.Era{Name: "旧", Start: timex.MustNewDate(2100, 1, 1)})
+		assert.EqualError(t, err, "era does not start after the last era")
+		err = calendar.AddEra(timex.Era{Start: timex.MustNewDate(2200, 1, 1)})
+		assert.EqualError(t, err, "era name is empty")
+	})
+}
+
+func TestEraCalendarFormat(t *testing.T) {
+	tests := []struct {
+		calendar timex.EraCalendar
+		layout   string
+		date     timex.Date
+		value    string
+	}{
+		{timex.JapaneseCalendar, "GGGGe年M月D日", timex.MustNewDate(2024, 3, 5), "令和6年3月5日"},
+		{timex.JapaneseCalendar, "Gee.MM.DD", timex.MustNewDate(2024, 3, 5), "R06.03.05"},
+		{timex.JapaneseCalendar, "GGGGe年M月D日", timex.MustNewDate(1989, 1, 7), "昭和64年1月7日"},
+		{timex.JapaneseCalendar, "YYYY年M月D日（GGGGe年）", timex.MustNewDate(2019, 1, 1), "2019年1月1日（平成31年）"},
+		{timex.MinguoCalendar, "GGGGe年M月D日", timex.MustNewDate(2024, 3, 5), "民國113年3月5日"},
+		{timex.MinguoCalendar, "GGGGe年M月D日", timex.MustNewDate(1911, 10, 10), "民國前1年10月10日"},
+		{timex.ThaiBuddhistCalendar, "D MMMM G e", timex.MustNewDate(2024, 3, 5), "5 March พ.ศ. 2567"},
+		{timex.ThaiBuddhistCalendar, "DD/MM/e", timex.MustNewDate(2024, 3, 5), "05/03/2567"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.value, tt.calendar.Format(tt.date, tt.layout))
+
+		date, err := tt.calendar.Parse(tt.layout, tt.value)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.date, date)
+	}
+
+	date, err := timex.JapaneseCalendar.Parse("GGGGe年M月D日", "令和元年5月1日")
+	assert.NoError(t, err)
+	assert.Equal(t, timex.MustNewDate(2019, 5, 1), date)
+
+	date, err = timex.JapaneseCalendar.Parse("YYYY-MM-DD", "2024-03-05")
+	assert.NoError(t, err)
+	assert.Equal(t, timex.MustNewDate(2024, 3, 5), date)
+
+	_, err = timex.JapaneseCalendar.Parse("GGGGe年M月D日", "平成32年1月1日")
+	assert.EqualError(t, err, "date is out of range of the era")
+
+	_, err = timex.JapaneseCalendar.Parse("GGGGe年M月D日", "天保1年1月1日")
+	assert.EqualError(t, err, `parsing "天保1年1月1日" as "GGGGe年M月D日": cannot parse "天保1年1月1日" as "GGGG"`)
+
+	assert.Equal(t, "0年", timex.JapaneseCalendar.Format(timex.MustNewDate(1868, 1, 1), "GGGGe年"))
+	assert.Equal(t, "0", timex.EraCalendar{}.Format(timex.MustNewDate(2024, 3, 5), "Ge"))
+
+	// The year of era is in the last era without the era name.
+	date, err = timex.JapaneseCalendar.Parse("e年M月D日", "6年3月5日")
+	assert.NoError(t, err)
+	assert.Equal(t, timex.MustNewDate(2024, 3, 5), date)
+
+	_, err = timex.EraCalendar{}.Parse("e-MM-DD", "6-03-05")
+	assert.EqualError(t, err, "era is missing")
+
+	// The era tokens next to ASCII letters are literal.
+	layout := "Week of GGGGe年M月D日, Generation G, Gen e"
+	value := "Week of 令和6年3月5日, Generation R, Gen 6"
+	assert.Equal(t, value, timex.JapaneseCalendar.Format(timex.MustNewDate(2024, 3, 5), layout))
+	date, err = timex.JapaneseCalendar.Parse(layout, value)
+	assert.NoError(t, err)
+	assert.Equal(t, timex.MustNewDate(2024, 3, 5), date)
+
+	// The era tokens are literal in ParseDate and Date.Format.
+	assert.Equal(t, "G e 2024", timex.MustNewDate(2024, 3, 5).Format("G e YYYY"))
+}
@@ -1,6 +1,81 @@
 package timex
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+)
+
+// ErrorKind is the kind of an error of parsing or creating a value.
+type ErrorKind int
+
+// The kinds of errors.
+const (
+	KindSyntax ErrorKind = iota // The value does not match the layout.
+	KindYearOutOfRange
+	KindQuarterOutOfRange
+	KindMonthOutOfRange
+	KindWeekOutOfRange
+	KindDayOutOfRange
+	KindHourOutOfRange
+	KindMinuteOutOfRange
+	KindSecondOutOfRange
+	KindNanosecondOutOfRange
+	KindTrailingData // The value has data after the layout.
+	KindAmbiguous    // The value can be parsed in more than one way.
+	KindSkippedDate  // The date is skipped by the cutover of a calendar.
+)
+
+// The sentinel errors of the kinds, an error of a kind matches its sentinel error by errors.Is.
+var (
+	ErrSyntax               = errors.New("syntax error")
+	ErrYearOutOfRange       = errors.New("year is out of range")
+	ErrQuarterOutOfRange    = errors.New("quarter is out of range")
+	ErrMonthOutOfRange      = errors.New("month is out of range")
+	ErrWeekOutOfRange       = errors.New("week is out of range")
+	ErrDayOutOfRange        = errors.New("day is out of range")
+	ErrHourOutOfRange       = errors.New("hour is out of range")
+	ErrMinuteOutOfRange     = errors.New("minute is out of range")
+	ErrSecondOutOfRange     = errors.New("second is out of range")
+	ErrNanosecondOutOfRange = errors.New("nanosecond is out of range")
+	ErrTrailingData         = errors.New("trailing data")
+	ErrAmbiguous            = errors.New("ambiguous value")
+	ErrSkippedDate          = errors.New("date is skipped by the cutover")
+)
+
+var errorKinds = [...]struct {
+	name string
+	err  error
+}{
+	KindSyntax:               {"syntax", ErrSyntax},
+	KindYearOutOfRange:       {"year", ErrYearOutOfRange},
+	KindQuarterOutOfRange:    {"quarter", ErrQuarterOutOfRange},
+	KindMonthOutOfRange:      {"month", ErrMonthOutOfRange},
+	KindWeekOutOfRange:       {"week", ErrWeekOutOfRange},
+	KindDayOutOfRange:        {"day", ErrDayOutOfRange},
+	KindHourOutOfRange:       {"hour", ErrHourOutOfRange},
+	KindMinuteOutOfRange:     {"minute", ErrMinuteOutOfRange},
+	KindSecondOutOfRange:     {"second", ErrSecondOutOfRange},
+	KindNanosecondOutOfRange: {"nanosecond", ErrNanosecondOutOfRange},
+	KindTrailingData:         {"trailing data", ErrTrailingData},
+	KindAmbiguous:            {"ambiguous", ErrAmbiguous},
+	KindSkippedDate:          {"skipped date", ErrSkippedDate},
+}
+
+// String returns the name of the kind, the out of range kinds are named by the element, such as day.
+func (k ErrorKind) String() string {
+	if k < 0 || int(k) >= len(errorKinds) {
+		return fmt.Sprintf("ErrorKind(%d)", int(k))
+	}
+	return errorKinds[k].name
+}
+
+// Err returns the sentinel error of the kind.
+func (k ErrorKind) Err() error {
+	if k < 0 || int(k) >= len(errorKinds) {
+		return nil
+	}
+	return errorKinds[k].err
+}
 
 // ParseError describes a problem parsing a string.
 type ParseError struct {
@@ -8,12 +83,87 @@ type ParseError struct {
 	Value      string
 	LayoutElem string
 	ValueElem  string
+	// Offset is the byte offset in Value where the problem is found, which is the start of ValueElem.
+	Offset int
+	// Kind is the kind of the problem.
+	Kind ErrorKind
+	// Err is the underlying error if any, such as a *RangeError.
+	Err error
 }
 
 // Error returns the string representation of a ParseError.
 func (e *ParseError) Error() string {
-	if len(e.LayoutElem) == 0 && len(e.ValueElem) == 0 {
+	switch {
+	case e.Kind == KindTrailingData:
+		return fmt.Sprintf("parsing %q as %q: extra text %q", e.Value, e.Layout, e.ValueElem)
+	case len(e.Layout) != 0 && e.Err != nil:
+		return fmt.Sprintf("parsing %q as %q: %s", e.Value, e.Layout, e.Err)
+	case len(e.LayoutElem) == 0 && len(e.ValueElem) == 0 && e.Err != nil:
+		return fmt.Sprintf("parsing %q: %s", e.Value, e.Err)
+	case len(e.LayoutElem) == 0 && len(e.ValueElem) == 0:
 		return fmt.Sprintf("parsing %q as %q", e.Value, e.Layout)
 	}
 	return fmt.Sprintf("parsing %q as %q: cannot parse %q as %q", e.Value, e.Layout, e.ValueElem, e.LayoutElem)
 }
+
+// Unwrap returns the underlying error.
+func (e *ParseError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether the target is the sentinel error of the kind of e.
+func (e *ParseError) Is(target error) bool {
+	return target != nil && target == e.Kind.Err()
+}
+
+// elemSpan is an element parsed from a string, which locates the error of the element.
+type elemSpan struct {
+	layoutElem, valueElem string
+	offset                int
+}
+
+// elemSpans is the elements parsed from a string by the kinds of their range errors.
+type elemSpans [KindTrailingData]elemSpan
+
+// parseError wraps err in a *ParseError at the element of its kind if err is a *RangeError,
+// other errors are returned unchanged.
+func (s *elemSpans) parseError(layout, value string, err error) error {
+	e, ok := err.(*RangeError)
+	if !ok || e.Kind < 0 || int(e.Kind) >= len(s) {
+		return err
+	}
+	span := s[e.Kind]
+	return &ParseError{Layout: layout, Value: value, LayoutElem: span.layoutElem, ValueElem: span.valueElem, Offset: span.offset, Kind: e.Kind, Err: e}
+}
+
+// RangeError describes an element of date or time which is out of range, such as the day 30 of February.
+type RangeError struct {
+	// Kind is the kind of the element out of range, such as KindDayOutOfRange.
+	Kind ErrorKind
+	// Elem is the name of the element if it is not the name of the kind, such as day of year.
+	Elem string
+	// Value is the value of the element, and Min and Max are the inclusive range of it.
+	Value, Min, Max int
+}
+
+// rangeError returns a *RangeError of the element named by the kind.
+func rangeError(kind ErrorKind, value, min, max int) error {
+	return &RangeError{Kind: kind, Value: value, Min: min, Max: max}
+}
+
+// Error returns the string representation of a RangeError.
+func (e *RangeError) Error() string {
+	elem := e.Elem
+	if elem == "" {
+		elem = e.Kind.String()
+	}
+	if e.Kind == KindNanosecondOutOfRange && e.Min == 0 && e.Max == 1e9-1 {
+		return elem + " is out of range [0,1e9)"
+	}
+	return fmt.Sprintf("%s is out of range [%d,%d]", elem, e.Min, e.Max)
+}
+
+// Is reports whether the target is the sentinel error of the kind of e.
+func (e *RangeError) Is(target error) bool {
+	return target != nil && target == e.Kind.Err()
+}
@@ -0,0 +1,144 @@
+package timex_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/invzhi/timex"
+)
+
+func TestParseError(t *testing.T) {
+	tests := []struct {
+		err    error
+		kind   timex.ErrorKind
+		offset int
+		target error
+	}{
+		{parseDateErr("YYYY-MM-DD", "2024-1-05"), timex.KindSyntax, 5, timex.ErrSyntax},
+		{parseDateErr("YYYY年M月D日", "2024年3-5"), timex.KindSyntax, 7, timex.ErrSyntax},
+		{parseDateErr("MMMM D, YYYY", "March 5 2024"), timex.KindSyntax, 7, timex.ErrSyntax},
+		{parseTimeErr("HH:mm:ss", "12:3x:00"), timex.KindSyntax, 3, timex.ErrSyntax},
+		{parseDateAnyErr("2024-03-05X", "YYYY-MM-DDZ"), timex.KindSyntax, 10, timex.ErrSyntax},
+		{parseDateAnyErr("2024-3-512", "YYYY-M-D"), timex.KindTrailingData, 9, timex.ErrTrailingData},
+		{parseDateAnyErr("03/05/2024"), timex.KindAmbiguous, 0, timex.ErrAmbiguous},
+	}
+
+	for _, tt := range tests {
+		var e *timex.ParseError
+		if assert.ErrorAs(t, tt.err, &e) {
+			assert.Equal(t, tt.kind, e.Kind, tt.err.Error())
+			assert.Equal(t, tt.offset, e.Offset, tt.err.Error())
+			assert.ErrorIs(t, tt.err, tt.target)
+			assert.NotErrorIs(t, tt.err, timex.ErrDayOutOfRange)
+		}
+	}
+
+	_, err := timex.ParseDateAny("2024-3-512", "YYYY-M-D")
+	assert.EqualError(t, err, `parsing "2024-3-512" as "YYYY-M-D": extra text "2"`)
+	_, err = timex.ParseDateAny("2024-03-05X", "YYYY-MM-DDZ")
+	assert.EqualError(t, err, `parsing "2024-03-05X" as "YYYY-MM-DDZ": cannot parse "X" as "Z"`)
+
+	t.Run("Range", func(t *testing.T) {
+		tests := []struct {
+			err        error
+			kind       timex.ErrorKind
+			offset     int
+			layoutElem string
+			valueElem  string
+			target     error
+		}{
+			{parseDateErr("YYYY-MM-DD", "2024-02-30"), timex.KindDayOutOfRange, 8, "DD", "30", timex.ErrDayOutOfRange},
+			{parseDateErr("YYYY IW", "2024 53"), timex.KindWeekOutOfRange, 5, "IW", "53", timex.ErrWeekOutOfRange},
+			{parseTimeErr("HH:mm:ss", "12:60:00"), timex.KindMinuteOutOfRange, 3, "mm", "60", timex.ErrMinuteOutOfRange},
+			{parseTimeErr("H:mm", "24:00"), timex.KindHourOutOfRange, 0, "H", "24", timex.ErrHourOutOfRange},
+		}
+
+		for _, tt := range tests {
+			var e *timex.ParseError
+			if assert.ErrorAs(t, tt.err, &e) {
+				assert.Equal(t, tt.kind, e.Kind, tt.err.Error())
+				assert.Equal(t, tt.offset, e.Offset, tt.err.Error())
+				assert.Equal(t, tt.layoutElem, e.LayoutElem, tt.err.Error())
+				assert.Equal(t, tt.valueElem, e.ValueElem, tt.err.Error())
+				assert.ErrorIs(t, tt.err, tt.target)
+			}
+		}
+
+		err := parseDateErr("YYYY-MM-DD", "2024-02-30")
+		assert.EqualError(t, err, `parsing "2024-02-30" as "YYYY-MM-DD": day is out of range [1,29]`)
+		var e *timex.RangeError
+		if assert.ErrorAs(t, err, &e) {
+			assert.Equal(t, 30, e.Value)
+		}
+	})
+}
+
+func TestRangeError(t *testing.T) {
+	tests := []struct {
+		err    error
+		kind   timex.ErrorKind
+		value  int
+		min    int
+		max    int
+		target error
+		msg    string
+	}{
+		{newDateErr(2024, 13, 1), timex.KindMonthOutOfRange, 13, 1, 12, timex.ErrMonthOutOfRange, "month is out of range [1,12]"},
+		{newDateErr(2023, 2, 29), timex.KindDayOutOfRange, 29, 1, 28, timex.ErrDayOutOfRange, "day is out of range [1,28]"},
+		{newTimeErr(24, 0, 0, 0), timex.KindHourOutOfRange, 24, 0, 23, timex.ErrHourOutOfRange, "hour is out of range [0,23]"},
+		{newTimeErr(12, 60, 0, 0), timex.KindMinuteOutOfRange, 60, 0, 59, timex.ErrMinuteOutOfRange, "minute is out of range [0,59]"},
+		{newTimeErr(12, 0, -1, 0), timex.KindSecondOutOfRange, -1, 0, 59, timex.ErrSecondOutOfRange, "second is out of range [0,59]"},
+		{newTimeErr(12, 0, 0, 1e9), timex.KindNanosecondOutOfRange, 1e9, 0, 1e9 - 1, timex.ErrNanosecondOutOfRange, "nanosecond is out of range [0,1e9)"},
+		{parseDateErr("YYYY-MM-DD", "2024-02-30"), timex.KindDayOutOfRange, 30, 1, 29, timex.ErrDayOutOfRange, `parsing "2024-02-30" as "YYYY-MM-DD": day is out of range [1,29]`},
+	}
+
+	for _, tt := range tests {
+		assert.EqualError(t, tt.err, tt.msg)
+		assert.ErrorIs(t, tt.err, tt.target)
+		assert.NotErrorIs(t, tt.err, timex.ErrSyntax)
+
+		var e *timex.RangeError
+		if assert.ErrorAs(t, tt.err, &e) {
+			assert.Equal(t, tt.kind, e.Kind)
+			assert.Equal(t, tt.value, e.Value)
+			assert.Equal(t, tt.min, e.Min)
+			assert.Equal(t, tt.max, e.Max)
+		}
+	}
+
+	_, err := timex.DateFromOrdinalDate(2023, 366)
+	assert.EqualError(t, err, "day of year is out of range [1,365]")
+	assert.ErrorIs(t, err, timex.ErrDayOutOfRange)
+
+	assert.Equal(t, "day", timex.KindDayOutOfRange.String())
+	assert.Equal(t, "ErrorKind(100)", timex.ErrorKind(100).String())
+	assert.True(t, errors.Is(timex.KindAmbiguous.Err(), timex.ErrAmbiguous))
+	assert.Equal(t, "skipped date", timex.KindSkippedDate.String())
+}
+
+func parseDateErr(layout, value string) error {
+	_, err := timex.ParseDate(layout, value)
+	return err
+}
+
+func parseTimeErr(layout, value string) error {
+	_, err := timex.ParseTimeOfDay(layout, value)
+	return err
+}
+
+func parseDateAnyErr(value string, layouts ...string) error {
+	_, err := timex.ParseDateAny(value, layouts...)
+	return err
+}
+
+func newDateErr(year, month, day int) error {
+	_, err := timex.NewDate(year, month, day)
+	return err
+}
+
+func newTimeErr(hour, min, sec, nsec int) error {
+	_, err := timex.NewTimeOfDay(hour, min, sec, nsec)
+	return err
+}
@@ -0,0 +1,263 @@
+package timex
+
+var ethiopianMonthNames = []string{
+	"Meskerem",
+	"Tikimt",
+	"Hidar",
+	"Tahsas",
+	"Tir",
+	"Yekatit",
+	"Megabit",
+	"Miazia",
+	"Genbot",
+	"Sene",
+	"Hamle",
+	"Nehase",
+	"Pagume",
+}
+
+var ethiopianMonthAmharicNames = []string{
+	"መስከረም",
+	"ጥቅምት",
+	"ኅዳር",
+	"ታኅሣሥ",
+	"ጥር",
+	"የካቲት",
+	"መጋቢት",
+	"ሚያዝያ",
+	"ግንቦት",
+	"ሰኔ",
+	"ሐምሌ",
+	"ነሐሴ",
+	"ጳጉሜን",
+}
+
+var copticMonthNames = []string{
+	"Thout",
+	"Paopi",
+	"Hathor",
+	"Koiak",
+	"Tobi",
+	"Meshir",
+	"Paremhat",
+	"Parmouti",
+	"Pashons",
+	"Paoni",
+	"Epip",
+	"Mesori",
+	"Nasie",
+}
+
+// The ordinals of the first days of Ethiopian and Coptic calendars.
+const (
+	ethiopianEpoch = 2795   // August 29, 8 in Julian calendar.
+	copticEpoch    = 103604 // August 29, 284 in Julian calendar.
+)
+
+const daysEvery4CopticYears = 4*365 + 1
+
+// alexandrianCalendar is the calendar which Ethiopian and Coptic calendars are based on,
+// a year has 12 months of 30 days and the 13th month of 5 days, or 6 days in a leap year.
+type alexandrianCalendar struct {
+	epoch int
+}
+
+// isLeap reports whether the year is a leap year, which is the year before a year divisible by 4.
+func (c alexandrianCalendar) isLeap(year int) bool {
+	return floorMod(year, 4) == 3
+}
+
+func (c alexandrianCalendar) daysInMonth(year, month int) int {
+	switch {
+	case month < 13:
+		return 30
+	case c.isLeap(year):
+		return 6
+	default:
+		return 5
+	}
+}
+
+func (c alexandrianCalendar) newDate(year, month, day int) (Date, error) {
+	if month < 1 || month > 13 {
+		return Date{}, rangeError(KindMonthOutOfRange, month, 1, 13)
+	}
+
+	if days := c.daysInMonth(year, month); day < 1 || day > days {
+		return Date{}, rangeError(KindDayOutOfRange, day, 1, days)
+	}
+
+	return Date{ordinal: c.toOrdinal(year, month, day)}, nil
+}
+
+func (c alexandrianCalendar) toOrdinal(year, month, day int) int {
+	return c.epoch + 365*(year-1) + floorDiv(year, 4) + 30*(month-1) + day - 1
+}
+
+func (c alexandrianCalendar) fromOrdinal(n int) (year, month, day int) {
+	n -= c.epoch
+	year = floorDiv(4*n+1463, daysEvery4CopticYears)
+	n -= 365*(year-1) + floorDiv(year, 4)
+	return year, n/30 + 1, n%30 + 1
+}
+
+// EthiopianCalendar is the Ethiopian calendar in the Amete Mihret era, which is 7 or 8 years behind Gregorian calendar.
+// A year has 12 months of 30 days and Pagume of 5 days, or 6 days in a leap year.
+// The year starts on Meskerem 1, which is September 11, or September 12 before a Gregorian leap year.
+type EthiopianCalendar struct {
+	// AmharicNames reports whether the month names are formatted in Amharic, otherwise transliterated.
+	AmharicNames bool
+}
+
+func (c EthiopianCalendar) names() dateNames {
+	if c.AmharicNames {
+		return dateNames{short: ethiopianMonthAmharicNames, long: ethiopianMonthAmharicNames}
+	}
+	return dateNames{short: ethiopianMonthNames, long: ethiopianMonthNames}
+}
+
+// IsLeapYear reports whether the year is a leap year, in which Pagume has 6 days.
+func (c EthiopianCalendar) IsLeapYear(year int) bool {
+	return alexandrianCalendar{epoch: ethiopianEpoch}.isLeap(year)
+}
+
+// DaysInMonth returns the number of days in the month of the year.
+func (c EthiopianCalendar) DaysInMonth(year, month int) int {
+	return alexandrianCalendar{epoch: ethiopianEpoch}.daysInMonth(year, month)
+}
+
+// NewDate returns the date corresponding to year, month, and day in the calendar.
+func (c EthiopianCalendar) NewDate(year, month, day int) (Date, error) {
+	return alexandrianCalendar{epoch: ethiopianEpoch}.newDate(year, month, day)
+}
+
+// MustNewDate is like NewDate but panics if the date cannot be created.
+func (c EthiopianCalendar) MustNewDate(year, month, day int) Date {
+	date, err := c.NewDate(year, month, day)
+	if err != nil {
+		panic(`timex: EthiopianCalendar.NewDate: ` + err.Error())
+	}
+	return date
+}
+
+// Date returns the year, month, and day specified by d in the calendar.
+func (c EthiopianCalendar) Date(d Date) (year, month, day int) {
+	return c.FromOrdinal(d.ordinal)
+}
+
+// ToOrdinal returns the ordinal of the year, month, and day in the calendar, see Calendar for the ordinal.
+func (c EthiopianCalendar) ToOrdinal(year, month, day int) int {
+	return alexandrianCalendar{epoch: ethiopianEpoch}.toOrdinal(year, month, day)
+}
+
+// FromOrdinal returns the year, month, and day of the ordinal in the calendar, see Calendar for the ordinal.
+func (c EthiopianCalendar) FromOrdinal(n int) (year, month, day int) {
+	return alexandrianCalendar{epoch: ethiopianEpoch}.fromOrdinal(n)
+}
+
+// MonthsInYear returns the number of months in the year, which is 13.
+func (c EthiopianCalendar) MonthsInYear(year int) int {
+	return 13
+}
+
+// MonthName returns the name of the month, such as Meskerem, or in Amharic if AmharicNames is true.
+func (c EthiopianCalendar) MonthName(year, month int) string {
+	return c.names().long[month-1]
+}
+
+// Parse parses a formatted string and returns the date it represents in the calendar.
+// The layout uses the tokens of ParseDate, and the month names are the Ethiopian month names.
+func (c EthiopianCalendar) Parse(layout, value string) (Date, error) {
+	f, err := parseDate(layout, value, elemYear|elemMonth|elemDay, c.names())
+	if err != nil {
+		return Date{}, err
+	}
+	date, err := c.NewDate(f.year, f.month, f.day)
+	return date, f.parseError(err)
+}
+
+// Format returns a textual representation of the date d in the calendar.
+// The layout uses the tokens of Date.Format, and the month names are the Ethiopian month names.
+func (c EthiopianCalendar) Format(d Date, layout string) string {
+	var f dateFields
+	f.year, f.month, f.day = c.Date(d)
+	bytes := make([]byte, 0, len(layout)+10)
+	bytes = appendDate(bytes, layout, elemYear|elemMonth|elemDay, c.names(), f)
+	return string(bytes)
+}
+
+// CopticCalendar is the Coptic calendar in the Era of Martyrs, which is 276 years behind Ethiopian calendar.
+// A year has 12 months of 30 days and Nasie of 5 days, or 6 days in a leap year.
+type CopticCalendar struct{}
+
+var copticNames = dateNames{short: copticMonthNames, long: copticMonthNames}
+
+// IsLeapYear reports whether the year is a leap year, in which Nasie has 6 days.
+func (c CopticCalendar) IsLeapYear(year int) bool {
+	return alexandrianCalendar{epoch: copticEpoch}.isLeap(year)
+}
+
+// DaysInMonth returns the number of days in the month of the year.
+func (c CopticCalendar) DaysInMonth(year, month int) int {
+	return alexandrianCalendar{epoch: copticEpoch}.daysInMonth(year, month)
+}
+
+// NewDate returns the date corresponding to year, month, and day in the calendar.
+func (c CopticCalendar) NewDate(year, month, day int) (Date, error) {
+	return alexandrianCalendar{epoch: copticEpoch}.newDate(year, month, day)
+}
+
+// MustNewDate is like NewDate but panics if the date cannot be created.
+func (c CopticCalendar) MustNewDate(year, month, day int) Date {
+	date, err := c.NewDate(year, month, day)
+	if err != nil {
+		panic(`timex: CopticCalendar.NewDate: ` + err.Error())
+	}
+	return date
+}
+
+// Date returns the year, month, and day specified by d in the calendar.
+func (c CopticCalendar) Date(d Date) (year, month, day int) {
+	return c.FromOrdinal(d.ordinal)
+}
+
+// ToOrdinal returns the ordinal of the year, month, and day in the calendar, see Calendar for the ordinal.
+func (c CopticCalendar) ToOrdinal(year, month, day int) int {
+	return alexandrianCalendar{epoch: copticEpoch}.toOrdinal(year, month, day)
+}
+
+// FromOrdinal returns the year, month, and day of the ordinal in the calendar, see Calendar for the ordinal.
+func (c CopticCalendar) FromOrdinal(n int) (year, month, day int) {
+	return alexandrianCalendar{epoch: copticEpoch}.fromOrdinal(n)
+}
+
+// MonthsInYear returns the number of months in the year, which is 13.
+func (c CopticCalendar) MonthsInYear(year int) int {
+	return 13
+}
+
+// MonthName returns the transliterated name of the month, such as Thout.
+func (c CopticCalendar) MonthName(year, month int) string {
+	return copticMonthNames[month-1]
+}
+
+// Parse parses a formatted string and returns the date it represents in the calendar.
+// The layout uses the tokens of ParseDate, and the month names are the transliterated Coptic month names.
+func (c CopticCalendar) Parse(layout, value string) (Date, error) {
+	f, err := parseDate(layout, value, elemYear|elemMonth|elemDay, copticNames)
+	if err != nil {
+		return Date{}, err
+	}
+	date, err := c.NewDate(f.year, f.month, f.day)
+	return date, f.parseError(err)
+}
+
+// Format returns a textual representation of the date d in the calendar.
+// The layout uses the tokens of Date.Format, and the month names are the transliterated Coptic month names.
+func (c CopticCalendar) Format(d Date, layout string) string {
+	var f dateFields
+	f.year, f.month, f.day = c.Date(d)
+	bytes := make([]byte, 0, len(layout)+10)
+	bytes = appendDate(bytes, layout, elemYear|elemMonth|elemDay, copticNames, f)
+	return string(bytes)
+}
@@ -0,0 +1,136 @@
+package timex_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/invzhi/timex"
+)
+
+func TestEthiopianCalendar(t *testing.T) {
+	var calendar timex.EthiopianCalendar
+
+	tests := []struct {
+		year, month, day int
+		date             timex.Date
+	}{
+		{1, 1, 1, timex.MustDateFromJulianCalendar(8, 8, 29)},
+		{1963, 4, 23, timex.MustNewDate(1971, 1, 1)},
+		{1992, 4, 22, timex.MustNewDate(2000, 1, 1)},
+		{2015, 13, 6, timex.MustNewDate(2023, 9, 11)},
+		{2016, 1, 1, timex.MustNewDate(2023, 9, 12)},
+		{2016, 13, 5, timex.MustNewDate(2024, 9, 10)},
+		{2017, 1, 1, timex.MustNewDate(2024, 9, 11)},
+		{2017, 5, 11, timex.MustNewDate(2025, 1, 19)},
+		{0, 13, 5, timex.MustDateFromJulianCalendar(8, 8, 28)},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.date, calendar.MustNewDate(tt.year, tt.month, tt.day))
+
+		year, month, day := calendar.Date(tt.date)
+		assert.Equal(t, tt.year, year)
+		assert.Equal(t, tt.month, month)
+		assert.Equal(t, tt.day, day)
+	}
+
+	assert.True(t, calendar.IsLeapYear(2015))
+	assert.False(t, calendar.IsLeapYear(2016))
+	assert.True(t, calendar.IsLeapYear(-1))
+	assert.Equal(t, 30, calendar.DaysInMonth(2016, 12))
+	assert.Equal(t, 6, calendar.DaysInMonth(2019, 13))
+	assert.Equal(t, 5, calendar.DaysInMonth(2016, 13))
+
+	t.Run("Continuous", func(t *testing.T) {
+		date := timex.MustNewDate(-100, 1, 1)
+		for n := 0; n < 100000; n++ {
+			year, month, day := calendar.Date(date)
+			assert.Equal(t, date, calendar.MustNewDate(year, month, day))
+			date = date.AddDays(3)
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		tests := []struct {
+			year, month, day int
+			err              string
+		}{
+			{2016, 0, 1, "month is out of range [1,13]"},
+			{2016, 14, 1, "month is out of range [1,13]"},
+			{2016, 1, 31, "day is out of range [1,30]"},
+			{2016, 13, 6, "day is out of range [1,5]"},
+			{2015, 13, 0, "day is out of range [1,6]"},
+		}
+
+		for _, tt := range tests {
+			_, err := calendar.NewDate(tt.year, tt.month, tt.day)
+			assert.EqualError(t, err, tt.err)
+		}
+
+		assert.PanicsWithValue(t, "timex: EthiopianCalendar.NewDate: day is out of range [1,5]", func() {
+			calendar.MustNewDate(2016, 13, 6)
+		})
+	})
+}
+
+func TestCopticCalendar(t *testing.T) {
+	var calendar timex.CopticCalendar
+
+	tests := []struct {
+		year, month, day int
+		date             timex.Date
+	}{
+		{1, 1, 1, timex.MustDateFromJulianCalendar(284, 8, 29)},
+		{1716, 4, 22, timex.MustNewDate(2000, 1, 1)},
+		{1739, 13, 6, timex.MustNewDate(2023, 9, 11)},
+		{1740, 1, 1, timex.MustNewDate(2023, 9, 12)},
+		{1741, 1, 1, timex.MustNewDate(2024, 9, 11)},
+		{1741, 4, 29, timex.MustNewDate(2025, 1, 7)},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.date, calendar.MustNewDate(tt.year, tt.month, tt.day))
+
+		year, month, day := calendar.Date(tt.date)
+		assert.Equal(t, tt.year, year)
+		assert.Equal(t, tt.month, month)
+		assert.Equal(t, tt.day, day)
+	}
+
+	assert.True(t, calendar.IsLeapYear(1739))
+	assert.Equal(t, 6, calendar.DaysInMonth(1739, 13))
+
+	_, err := calendar.NewDate(1740, 13, 6)
+	assert.EqualError(t, err, "day is out of range [1,5]")
+	assert.PanicsWithValue(t, "timex: CopticCalendar.NewDate: month is out of range [1,13]", func() {
+		calendar.MustNewDate(1740, 14, 1)
+	})
+}
+
+func TestEthiopianCalendarFormat(t *testing.T) {
+	tests := []struct {
+		format func(timex.Date, string) string
+		parse  func(string, string) (timex.Date, error)
+		layout string
+		date   timex.Date
+		value  string
+	}{
+		{timex.EthiopianCalendar{}.Format, timex.EthiopianCalendar{}.Parse, "YYYY-MM-DD", timex.MustNewDate(2024, 9, 11), "2017-01-01"},
+		{timex.EthiopianCalendar{}.Format, timex.EthiopianCalendar{}.Parse, "MMMM D, YYYY", timex.MustNewDate(2024, 9, 10), "Pagume 5, 2016"},
+		{timex.EthiopianCalendar{AmharicNames: true}.Format, timex.EthiopianCalendar{AmharicNames: true}.Parse, "MMMM D YYYY", timex.MustNewDate(2025, 1, 19), "ጥር 11 2017"},
+		{timex.CopticCalendar{}.Format, timex.CopticCalendar{}.Parse, "D MMMM YYYY", timex.MustNewDate(2025, 1, 7), "29 Koiak 1741"},
+		{timex.CopticCalendar{}.Format, timex.CopticCalendar{}.Parse, "D MMM YYYY", timex.MustNewDate(2023, 9, 11), "6 Nasie 1739"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.value, tt.format(tt.date, tt.layout))
+
+		date, err := tt.parse(tt.layout, tt.value)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.date, date)
+	}
+
+	_, err := timex.EthiopianCalendar{}.Parse("YYYY-MM-DD", "2016-13-06")
+	assert.EqualError(t, err, `parsing "2016-13-06" as "YYYY-MM-DD": day is out of range [1,5]`)
+}
@@ -0,0 +1,103 @@
+package timex
+
+import (
+	"errors"
+	"fmt"
+	"math"
+)
+
+// ExcelDateSystem is the date system of serial numbers in spreadsheets.
+type ExcelDateSystem int
+
+const (
+	// ExcelDateSystem1900 is the default date system, January 1, 1900 is serial 1.
+	// It treats 1900 as a leap year like Lotus 1-2-3, serial 60 is the nonexistent February 29, 1900.
+	ExcelDateSystem1900 ExcelDateSystem = iota
+	// ExcelDateSystem1904 is the date system of early Excel for Macintosh, January 1, 1904 is serial 0.
+	ExcelDateSystem1904
+)
+
+// The ordinals of the epochs of serial numbers, which are days since January 1 of year 1.
+const (
+	excel1900Epoch     = 693594  // December 31, 1899, which is serial 0 before March 1, 1900.
+	excel1900LeapEpoch = 693593  // December 30, 1899, which is serial 0 since March 1, 1900.
+	excel1904Epoch     = 695055  // January 1, 1904, which is serial 0.
+	excelLeapDaySerial = 60      // The nonexistent February 29, 1900.
+	excelMaxDate       = 3652058 // December 31, 9999.
+	msecsEveryDay      = 24 * 60 * 60 * 1000
+)
+
+func (s ExcelDateSystem) maxSerial() int {
+	if s == ExcelDateSystem1904 {
+		return excelMaxDate - excel1904Epoch
+	}
+	return excelMaxDate - excel1900LeapEpoch
+}
+
+// excelSerialToMilliseconds rounds the serial number to milliseconds like spreadsheets do.
+func excelSerialToMilliseconds(n float64) (int64, bool) {
+	if math.IsNaN(n) || math.IsInf(n, 0) || n < 0 || n >= float64(ExcelDateSystem1900.maxSerial()+1) {
+		return 0, false
+	}
+	return int64(math.Round(n * msecsEveryDay)), true
+}
+
+// DateFromExcelSerial returns the date of the serial number in the date system.
+// The serial number is rounded to milliseconds, and the fractional part which is the time of day is ignored.
+//
+// In ExcelDateSystem1900, serial 0 is December 31, 1899, and serial 60 which is February 29, 1900 is an error.
+// The serial number must be in range of January 1, 1900 or 1904 and December 31, 9999.
+func DateFromExcelSerial(n float64, system ExcelDateSystem) (Date, error) {
+	msecs, ok := excelSerialToMilliseconds(n)
+	serial := int(msecs / msecsEveryDay)
+	if max := system.maxSerial(); !ok || serial > max {
+		return Date{}, fmt.Errorf("serial is out of range [0,%d]", max)
+	}
+
+	switch {
+	case system == ExcelDateSystem1904:
+		return Date{ordinal: serial + excel1904Epoch}, nil
+	case serial < excelLeapDaySerial:
+		return Date{ordinal: serial + excel1900Epoch}, nil
+	case serial == excelLeapDaySerial:
+		return Date{}, errors.New("serial 60 is February 29, 1900 which does not exist")
+	default:
+		return Date{ordinal: serial + excel1900LeapEpoch}, nil
+	}
+}
+
+// ExcelSerial returns the serial number of the date d in the date system.
+// The date must be in range of December 31, 1899 or January 1, 1904 and December 31, 9999.
+func (d Date) ExcelSerial(system ExcelDateSystem) (int, error) {
+	var serial int
+	switch {
+	case system == ExcelDateSystem1904:
+		serial = d.ordinal - excel1904Epoch
+	case d.ordinal-excel1900Epoch < excelLeapDaySerial:
+		serial = d.ordinal - excel1900Epoch
+	default:
+		serial = d.ordinal - excel1900LeapEpoch
+	}
+
+	if serial < 0 || serial > system.maxSerial() {
+		return 0, errors.New("date is out of range of the date system")
+	}
+	return serial, nil
+}
+
+// TimeOfDayFromExcelSerial returns the time of day of the fractional part of the serial number.
+// The serial number is rounded to the nearest millisecond, so 0.99999999 is 23:59:59.999,
+// and a fraction which rounds up to a whole day such as 0.999999999 wraps to 00:00:00,
+// for which DateFromExcelSerial returns the next date.
+func TimeOfDayFromExcelSerial(n float64) (TimeOfDay, error) {
+	msecs, ok := excelSerialToMilliseconds(n)
+	if !ok {
+		return TimeOfDay{}, fmt.Errorf("serial is out of range [0,%d]", ExcelDateSystem1900.maxSerial())
+	}
+	return TimeOfDay{n: msecs % msecsEveryDay * 1e6}, nil
+}
+
+// ExcelSerial returns the fraction of a day elapsed at the time of day t, in range [0,1].
+func (t TimeOfDay) ExcelSerial() float64 {
+	return float64(t.n) / nsecsEveryDay
+}
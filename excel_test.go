@@ -0,0 +1,121 @@
+package timex_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/invzhi/timex"
+)
+
+func TestDateFromExcelSerial(t *testing.T) {
+	tests := []struct {
+		system timex.ExcelDateSystem
+		serial int
+		date   timex.Date
+	}{
+		{timex.ExcelDateSystem1900, 0, timex.MustNewDate(1899, 12, 31)},
+		{timex.ExcelDateSystem1900, 1, timex.MustNewDate(1900, 1, 1)},
+		{timex.ExcelDateSystem1900, 59, timex.MustNewDate(1900, 2, 28)},
+		{timex.ExcelDateSystem1900, 61, timex.MustNewDate(1900, 3, 1)},
+		{timex.ExcelDateSystem1900, 25569, timex.MustNewDate(1970, 1, 1)},
+		{timex.ExcelDateSystem1900, 45351, timex.MustNewDate(2024, 2, 29)},
+		{timex.ExcelDateSystem1900, 2958465, timex.MustNewDate(9999, 12, 31)},
+		{timex.ExcelDateSystem1904, 0, timex.MustNewDate(1904, 1, 1)},
+		{timex.ExcelDateSystem1904, 24107, timex.MustNewDate(1970, 1, 1)},
+		{timex.ExcelDateSystem1904, 43889, timex.MustNewDate(2024, 2, 29)},
+		{timex.ExcelDateSystem1904, 2957003, timex.MustNewDate(9999, 12, 31)},
+	}
+
+	for _, tt := range tests {
+		date, err := timex.DateFromExcelSerial(float64(tt.serial), tt.system)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.date, date)
+
+		date, err = timex.DateFromExcelSerial(float64(tt.serial)+0.75, tt.system)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.date, date)
+
+		serial, err := tt.date.ExcelSerial(tt.system)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.serial, serial)
+	}
+
+	t.Run("Round", func(t *testing.T) {
+		date, err := timex.DateFromExcelSerial(45350.9999999999, timex.ExcelDateSystem1900)
+		assert.NoError(t, err)
+		assert.Equal(t, timex.MustNewDate(2024, 2, 29), date)
+
+		timeOfDay, err := timex.TimeOfDayFromExcelSerial(45350.9999999999)
+		assert.NoError(t, err)
+		assert.Equal(t, timex.TimeOfDay{}, timeOfDay)
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		tests := []struct {
+			system timex.ExcelDateSystem
+			serial float64
+			err    string
+		}{
+			{timex.ExcelDateSystem1900, 60, "serial 60 is February 29, 1900 which does not exist"},
+			{timex.ExcelDateSystem1900, 60.5, "serial 60 is February 29, 1900 which does not exist"},
+			{timex.ExcelDateSystem1900, -1, "serial is out of range [0,2958465]"},
+			{timex.ExcelDateSystem1900, 2958466, "serial is out of range [0,2958465]"},
+			{timex.ExcelDateSystem1900, math.NaN(), "serial is out of range [0,2958465]"},
+			{timex.ExcelDateSystem1900, math.Inf(1), "serial is out of range [0,2958465]"},
+			{timex.ExcelDateSystem1904, 2957004, "serial is out of range [0,2957003]"},
+		}
+
+		for _, tt := range tests {
+			_, err := timex.DateFromExcelSerial(tt.serial, tt.system)
+			assert.EqualError(t, err, tt.err)
+		}
+
+		dates := []struct {
+			system timex.ExcelDateSystem
+			date   timex.Date
+		}{
+			{timex.ExcelDateSystem1900, timex.MustNewDate(1899, 12, 30)},
+			{timex.ExcelDateSystem1904, timex.MustNewDate(1903, 12, 31)},
+			{timex.ExcelDateSystem1904, timex.MustNewDate(10000, 1, 1)},
+		}
+
+		for _, tt := range dates {
+			_, err := tt.date.ExcelSerial(tt.system)
+			assert.EqualError(t, err, "date is out of range of the date system")
+		}
+	})
+}
+
+func TestTimeOfDayFromExcelSerial(t *testing.T) {
+	tests := []struct {
+		serial    float64
+		timeOfDay timex.TimeOfDay
+	}{
+		{0, timex.MustNewTimeOfDay(0, 0, 0, 0)},
+		{0.5, timex.MustNewTimeOfDay(12, 0, 0, 0)},
+		{0.75, timex.MustNewTimeOfDay(18, 0, 0, 0)},
+		{45352.5, timex.MustNewTimeOfDay(12, 0, 0, 0)},
+		{1.0 / 86400, timex.MustNewTimeOfDay(0, 0, 1, 0)},
+		{0.604166666666667, timex.MustNewTimeOfDay(14, 30, 0, 0)},
+		{0.9999999, timex.MustNewTimeOfDay(23, 59, 59, 991000000)},
+		{0.99999999, timex.MustNewTimeOfDay(23, 59, 59, 999000000)},
+		{0.999999999, timex.MustNewTimeOfDay(0, 0, 0, 0)},
+		{45350.999999999, timex.MustNewTimeOfDay(0, 0, 0, 0)},
+	}
+
+	for _, tt := range tests {
+		timeOfDay, err := timex.TimeOfDayFromExcelSerial(tt.serial)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.timeOfDay, timeOfDay)
+	}
+
+	assert.Equal(t, 0.5, timex.MustNewTimeOfDay(12, 0, 0, 0).ExcelSerial())
+	assert.Equal(t, 0.0, timex.TimeOfDay{}.ExcelSerial())
+	assert.Equal(t, 1.0, timex.EndOfDay().ExcelSerial())
+	assert.InDelta(t, 0.604166666666667, timex.MustNewTimeOfDay(14, 30, 0, 0).ExcelSerial(), 1e-15)
+
+	_, err := timex.TimeOfDayFromExcelSerial(-0.5)
+	assert.EqualError(t, err, "serial is out of range [0,2958465]")
+}
@@ -0,0 +1,294 @@
+package timex
+
+import (
+	"fmt"
+	"time"
+)
+
+// FiscalCalendar is a fiscal year which starts on the first day of a month.
+//
+// The zero value of type FiscalCalendar is the same as the calendar year.
+// The methods treat the invalid fields as zero, see Validate.
+type FiscalCalendar struct {
+	// StartMonth is the month the fiscal year starts, such as 4 for April and 10 for October.
+	// Zero means January, and it is invalid out of range [0,12].
+	StartMonth int
+	// NamedByStartYear reports whether the fiscal year is named by the calendar year it starts,
+	// otherwise it is named by the calendar year it ends, such as the federal fiscal year of United States.
+	NamedByStartYear bool
+}
+
+// Validate returns a *RangeError if a field of the fiscal calendar is out of range.
+func (c FiscalCalendar) Validate() error {
+	if c.StartMonth < 0 || c.StartMonth > 12 {
+		return &RangeError{Kind: KindMonthOutOfRange, Elem: "start month", Value: c.StartMonth, Min: 0, Max: 12}
+	}
+	return nil
+}
+
+func (c FiscalCalendar) startMonth() int {
+	if c.StartMonth < 1 || c.StartMonth > 12 {
+		return 1
+	}
+	return c.StartMonth
+}
+
+// startYearOffset returns the difference of the calendar year the fiscal year starts and its name.
+func (c FiscalCalendar) startYearOffset() int {
+	if c.NamedByStartYear || c.startMonth() == 1 {
+		return 0
+	}
+	return -1
+}
+
+// YearStart returns the first day of the fiscal year.
+func (c FiscalCalendar) YearStart(year int) Date {
+	return Date{ordinal: calendarToOrdinal(year+c.startYearOffset(), c.startMonth(), 1)}
+}
+
+// YearEnd returns the last day of the fiscal year.
+func (c FiscalCalendar) YearEnd(year int) Date {
+	return c.YearStart(year + 1).AddDays(-1)
+}
+
+// Date returns the fiscal year, fiscal quarter and fiscal month of the date d.
+// The first month of fiscal year is fiscal month 1.
+func (c FiscalCalendar) Date(d Date) (year, quarter, month int) {
+	year, month, _ = ordinalToCalendar(d.ordinal)
+	year, month = norm1(year-c.startYearOffset(), month-c.startMonth()+1, 12)
+	return year, (month-1)/3 + 1, month
+}
+
+// Year returns the fiscal year of the date d.
+func (c FiscalCalendar) Year(d Date) int {
+	year, _, _ := c.Date(d)
+	return year
+}
+
+// Quarter returns the fiscal quarter of the date d.
+func (c FiscalCalendar) Quarter(d Date) int {
+	_, quarter, _ := c.Date(d)
+	return quarter
+}
+
+// Month returns the fiscal month of the date d.
+func (c FiscalCalendar) Month(d Date) int {
+	_, _, month := c.Date(d)
+	return month
+}
+
+// RetailPattern is the number of weeks in each month of a quarter in a retail calendar.
+type RetailPattern int
+
+const (
+	RetailPattern445 RetailPattern = iota // 4-4-5 weeks
+	RetailPattern454                      // 4-5-4 weeks
+	RetailPattern544                      // 5-4-4 weeks
+)
+
+// RetailYearEnd is the rule to choose the last day of a retail year.
+type RetailYearEnd int
+
+const (
+	// RetailYearEndLast ends the retail year on the last weekday of the end month.
+	RetailYearEndLast RetailYearEnd = iota
+	// RetailYearEndNearest ends the retail year on the weekday nearest to the last day of the end month.
+	RetailYearEndNearest
+)
+
+// RetailCalendar is a 52/53-week fiscal calendar, which always ends on the same weekday.
+// Each quarter has 13 weeks split into months by the pattern, and the 53rd week is added to a month when needed.
+//
+// The zero value of type RetailCalendar is a 4-4-5 calendar ending on the last sunday of December.
+// The methods treat the invalid fields as zero, see Validate.
+type RetailCalendar struct {
+	// EndMonth is the month the retail year ends, zero means December, and it is invalid out of range [0,12].
+	EndMonth int
+	// EndWeekday is the last weekday of the retail year, it is invalid out of range [Sunday,Saturday].
+	EndWeekday time.Weekday
+	// EndRule is the rule to choose the last day of the retail year, it is invalid if it is not a RetailYearEnd constant.
+	EndRule RetailYearEnd
+	// Pattern is the number of weeks in each month of a quarter, it is invalid if it is not a RetailPattern constant.
+	Pattern RetailPattern
+	// LeapWeekMonth is the fiscal month which gets the 53rd week, zero means the last month,
+	// and it is invalid out of range [0,12].
+	LeapWeekMonth int
+	// NamedByStartYear reports whether the retail year is named by the calendar year it starts,
+	// otherwise it is named by the calendar year it ends.
+	NamedByStartYear bool
+}
+
+// NRFCalendar is the 4-5-4 retail calendar of the National Retail Federation.
+// The year ends on the saturday nearest to the end of January, and is named by the calendar year it starts.
+var NRFCalendar = RetailCalendar{
+	EndMonth:         1,
+	EndWeekday:       time.Saturday,
+	EndRule:          RetailYearEndNearest,
+	Pattern:          RetailPattern454,
+	NamedByStartYear: true,
+}
+
+// Validate returns a *RangeError if a field of the retail calendar is out of range,
+// or an error if the end rule or the pattern is unknown.
+func (c RetailCalendar) Validate() error {
+	switch {
+	case c.EndRule != RetailYearEndLast && c.EndRule != RetailYearEndNearest:
+		return fmt.Errorf("unknown retail year end rule %d", int(c.EndRule))
+	case c.Pattern != RetailPattern445 && c.Pattern != RetailPattern454 && c.Pattern != RetailPattern544:
+		return fmt.Errorf("unknown retail pattern %d", int(c.Pattern))
+	case c.EndMonth < 0 || c.EndMonth > 12:
+		return &RangeError{Kind: KindMonthOutOfRange, Elem: "end month", Value: c.EndMonth, Min: 0, Max: 12}
+	case c.EndWeekday < time.Sunday || c.EndWeekday > time.Saturday:
+		return &RangeError{Kind: KindDayOutOfRange, Elem: "end weekday", Value: int(c.EndWeekday), Min: 0, Max: 6}
+	case c.LeapWeekMonth < 0 || c.LeapWeekMonth > 12:
+		return &RangeError{Kind: KindMonthOutOfRange, Elem: "leap week month", Value: c.LeapWeekMonth, Min: 0, Max: 12}
+	}
+	return nil
+}
+
+func (c RetailCalendar) endMonth() int {
+	if c.EndMonth < 1 || c.EndMonth > 12 {
+		return 12
+	}
+	return c.EndMonth
+}
+
+func (c RetailCalendar) endWeekday() time.Weekday {
+	if c.EndWeekday < time.Sunday || c.EndWeekday > time.Saturday {
+		return time.Sunday
+	}
+	return c.EndWeekday
+}
+
+func (c RetailCalendar) leapWeekMonth() int {
+	if c.LeapWeekMonth < 1 || c.LeapWeekMonth > 12 {
+		return 12
+	}
+	return c.LeapWeekMonth
+}
+
+// endYearOffset returns the difference of the calendar year of end month and the name of retail year.
+func (c RetailCalendar) endYearOffset() int {
+	if c.NamedByStartYear && c.endMonth() != 12 {
+		return 1
+	}
+	return 0
+}
+
+// YearEnd returns the last day of the retail year.
+func (c RetailCalendar) YearEnd(year int) Date {
+	year += c.endYearOffset()
+	month := c.endMonth()
+	last := Date{ordinal: calendarToOrdinal(year, month, daysInMonth(year, month))}
+
+	if c.EndRule == RetailYearEndNearest {
+		return last.AddDays(3).PreviousOrSame(c.endWeekday())
+	}
+	return last.PreviousOrSame(c.endWeekday())
+}
+
+// YearStart returns the first day of the retail year.
+func (c RetailCalendar) YearStart(year int) Date {
+	return c.YearEnd(year - 1).AddDays(1)
+}
+
+// Weeks returns the number of weeks in the retail year, it is 52 or 53.
+func (c RetailCalendar) Weeks(year int) int {
+	return (c.YearEnd(year).Sub(c.YearStart(year)) + 1) / 7
+}
+
+// weeksInMonth returns the number of weeks in the fiscal month of the retail year which has the given weeks.
+func (c RetailCalendar) weeksInMonth(weeks, month int) int {
+	n := 4
+	switch c.Pattern {
+	case RetailPattern454:
+		if month%3 == 2 {
+			n = 5
+		}
+	case RetailPattern544:
+		if month%3 == 1 {
+			n = 5
+		}
+	default: // RetailPattern445 and the invalid patterns.
+		if month%3 == 0 {
+			n = 5
+		}
+	}
+
+	if weeks == 53 && month == c.leapWeekMonth() {
+		n++
+	}
+	return n
+}
+
+// MonthStart returns the first day of the fiscal month in the retail year.
+func (c RetailCalendar) MonthStart(year, month int) (Date, error) {
+	if month < 1 || month > 12 {
+		return Date{}, rangeError(KindMonthOutOfRange, month, 1, 12)
+	}
+
+	start := c.YearStart(year)
+	weeks := c.Weeks(year)
+	for m := 1; m < month; m++ {
+		start = start.AddDays(c.weeksInMonth(weeks, m) * 7)
+	}
+	return start, nil
+}
+
+// MonthEnd returns the last day of the fiscal month in the retail year.
+func (c RetailCalendar) MonthEnd(year, month int) (Date, error) {
+	start, err := c.MonthStart(year, month)
+	if err != nil {
+		return Date{}, err
+	}
+	return start.AddDays(c.weeksInMonth(c.Weeks(year), month)*7 - 1), nil
+}
+
+// Year returns the retail year of the date d.
+func (c RetailCalendar) Year(d Date) int {
+	year := d.Year() - c.endYearOffset()
+	for d.After(c.YearEnd(year)) {
+		year++
+	}
+	for !d.After(c.YearEnd(year - 1)) {
+		year--
+	}
+	return year
+}
+
+// Date returns the retail year, fiscal quarter, fiscal month and week of the date d.
+// The first week of the retail year is week 1.
+func (c RetailCalendar) Date(d Date) (year, quarter, month, week int) {
+	year = c.Year(d)
+	weeks := c.Weeks(year)
+	week = d.Sub(c.YearStart(year))/7 + 1
+
+	n := week
+	for month = 1; month < 12; month++ {
+		w := c.weeksInMonth(weeks, month)
+		if n <= w {
+			break
+		}
+		n -= w
+	}
+
+	return year, (month-1)/3 + 1, month, week
+}
+
+// Quarter returns the fiscal quarter of the date d.
+func (c RetailCalendar) Quarter(d Date) int {
+	_, quarter, _, _ := c.Date(d)
+	return quarter
+}
+
+// Month returns the fiscal month of the date d.
+func (c RetailCalendar) Month(d Date) int {
+	_, _, month, _ := c.Date(d)
+	return month
+}
+
+// Week returns the week of retail year of the date d.
+func (c RetailCalendar) Week(d Date) int {
+	year := c.Year(d)
+	return d.Sub(c.YearStart(year))/7 + 1
+}
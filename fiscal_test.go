@@ -0,0 +1,237 @@
+package timex_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/invzhi/timex"
+)
+
+func TestFiscalCalendar(t *testing.T) {
+	tests := []struct {
+		calendar             timex.FiscalCalendar
+		date                 timex.Date
+		year, quarter, month int
+		start, end           timex.Date
+	}{
+		{
+			timex.FiscalCalendar{}, timex.MustNewDate(2024, 5, 10),
+			2024, 2, 5, timex.MustNewDate(2024, 1, 1), timex.MustNewDate(2024, 12, 31),
+		},
+		{
+			timex.FiscalCalendar{StartMonth: 10}, timex.MustNewDate(2023, 10, 1),
+			2024, 1, 1, timex.MustNewDate(2023, 10, 1), timex.MustNewDate(2024, 9, 30),
+		},
+		{
+			timex.FiscalCalendar{StartMonth: 10}, timex.MustNewDate(2024, 9, 30),
+			2024, 4, 12, timex.MustNewDate(2023, 10, 1), timex.MustNewDate(2024, 9, 30),
+		},
+		{
+			timex.FiscalCalendar{StartMonth: 10}, timex.MustNewDate(2024, 3, 15),
+			2024, 2, 6, timex.MustNewDate(2023, 10, 1), timex.MustNewDate(2024, 9, 30),
+		},
+		{
+			timex.FiscalCalendar{StartMonth: 4, NamedByStartYear: true}, timex.MustNewDate(2025, 3, 31),
+			2024, 4, 12, timex.MustNewDate(2024, 4, 1), timex.MustNewDate(2025, 3, 31),
+		},
+		{
+			timex.FiscalCalendar{StartMonth: 4, NamedByStartYear: true}, timex.MustNewDate(2024, 7, 1),
+			2024, 2, 4, timex.MustNewDate(2024, 4, 1), timex.MustNewDate(2025, 3, 31),
+		},
+		{
+			timex.FiscalCalendar{StartMonth: 7}, timex.MustNewDate(2024, 7, 1),
+			2025, 1, 1, timex.MustNewDate(2024, 7, 1), timex.MustNewDate(2025, 6, 30),
+		},
+	}
+
+	for _, tt := range tests {
+		year, quarter, month := tt.calendar.Date(tt.date)
+		assert.Equal(t, tt.year, year)
+		assert.Equal(t, tt.quarter, quarter)
+		assert.Equal(t, tt.month, month)
+
+		assert.Equal(t, tt.year, tt.calendar.Year(tt.date))
+		assert.Equal(t, tt.quarter, tt.calendar.Quarter(tt.date))
+		assert.Equal(t, tt.month, tt.calendar.Month(tt.date))
+
+		assert.Equal(t, tt.start, tt.calendar.YearStart(tt.year))
+		assert.Equal(t, tt.end, tt.calendar.YearEnd(tt.year))
+	}
+
+	t.Run("Validate", func(t *testing.T) {
+		assert.NoError(t, timex.FiscalCalendar{StartMonth: 10}.Validate())
+		assert.NoError(t, timex.FiscalCalendar{}.Validate())
+
+		for _, month := range []int{-1, 13} {
+			calendar := timex.FiscalCalendar{StartMonth: month}
+			err := calendar.Validate()
+			assert.EqualError(t, err, "start month is out of range [0,12]")
+			assert.ErrorIs(t, err, timex.ErrMonthOutOfRange)
+
+			// The invalid fields are treated as zero.
+			assert.Equal(t, timex.MustNewDate(2024, 1, 1), calendar.YearStart(2024))
+			year, quarter, m := calendar.Date(timex.MustNewDate(2024, 5, 6))
+			assert.Equal(t, [3]int{2024, 2, 5}, [3]int{year, quarter, m})
+		}
+	})
+}
+
+func TestRetailCalendar(t *testing.T) {
+	tests := []struct {
+		calendar   timex.RetailCalendar
+		year       int
+		start, end timex.Date
+		weeks      int
+	}{
+		{timex.NRFCalendar, 2016, timex.MustNewDate(2016, 1, 31), timex.MustNewDate(2017, 1, 28), 52},
+		{timex.NRFCalendar, 2017, timex.MustNewDate(2017, 1, 29), timex.MustNewDate(2018, 2, 3), 53},
+		{timex.NRFCalendar, 2022, timex.MustNewDate(2022, 1, 30), timex.MustNewDate(2023, 1, 28), 52},
+		{timex.NRFCalendar, 2023, timex.MustNewDate(2023, 1, 29), timex.MustNewDate(2024, 2, 3), 53},
+		{timex.NRFCalendar, 2024, timex.MustNewDate(2024, 2, 4), timex.MustNewDate(2025, 2, 1), 52},
+		// Last saturday of September, such as Apple.
+		{
+			timex.RetailCalendar{EndMonth: 9, EndWeekday: time.Saturday},
+			2023, timex.MustNewDate(2022, 9, 25), timex.MustNewDate(2023, 9, 30), 53,
+		},
+		{
+			timex.RetailCalendar{EndMonth: 9, EndWeekday: time.Saturday},
+			2024, timex.MustNewDate(2023, 10, 1), timex.MustNewDate(2024, 9, 28), 52,
+		},
+		// Nearest sunday to the end of December.
+		{
+			timex.RetailCalendar{EndWeekday: time.Sunday, EndRule: timex.RetailYearEndNearest},
+			2023, timex.MustNewDate(2023, 1, 2), timex.MustNewDate(2023, 12, 31), 52,
+		},
+		{
+			timex.RetailCalendar{EndWeekday: time.Sunday, EndRule: timex.RetailYearEndNearest},
+			2024, timex.MustNewDate(2024, 1, 1), timex.MustNewDate(2024, 12, 29), 52,
+		},
+		{
+			timex.RetailCalendar{EndWeekday: time.Sunday, EndRule: timex.RetailYearEndNearest},
+			2026, timex.MustNewDate(2025, 12, 29), timex.MustNewDate(2027, 1, 3), 53,
+		},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.start, tt.calendar.YearStart(tt.year))
+		assert.Equal(t, tt.end, tt.calendar.YearEnd(tt.year))
+		assert.Equal(t, tt.weeks, tt.calendar.Weeks(tt.year))
+
+		assert.Equal(t, tt.year, tt.calendar.Year(tt.start))
+		assert.Equal(t, tt.year, tt.calendar.Year(tt.end))
+		assert.Equal(t, tt.year-1, tt.calendar.Year(tt.start.AddDays(-1)))
+		assert.Equal(t, tt.year+1, tt.calendar.Year(tt.end.AddDays(1)))
+
+		assert.Equal(t, 1, tt.calendar.Week(tt.start))
+		assert.Equal(t, tt.weeks, tt.calendar.Week(tt.end))
+		assert.Equal(t, 1, tt.calendar.Month(tt.start))
+		assert.Equal(t, 12, tt.calendar.Month(tt.end))
+		assert.Equal(t, 1, tt.calendar.Quarter(tt.start))
+		assert.Equal(t, 4, tt.calendar.Quarter(tt.end))
+
+		start, err := tt.calendar.MonthStart(tt.year, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.start, start)
+
+		end, err := tt.calendar.MonthEnd(tt.year, 12)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.end, end)
+	}
+
+	t.Run("Pattern", func(t *testing.T) {
+		patterns := []struct {
+			pattern       timex.RetailPattern
+			leapWeekMonth int
+			weeks         [12]int
+		}{
+			{timex.RetailPattern445, 0, [12]int{4, 4, 5, 4, 4, 5, 4, 4, 5, 4, 4, 6}},
+			{timex.RetailPattern454, 0, [12]int{4, 5, 4, 4, 5, 4, 4, 5, 4, 4, 5, 5}},
+			{timex.RetailPattern544, 0, [12]int{5, 4, 4, 5, 4, 4, 5, 4, 4, 5, 4, 5}},
+			{timex.RetailPattern445, 9, [12]int{4, 4, 5, 4, 4, 5, 4, 4, 6, 4, 4, 5}},
+		}
+
+		for _, tt := range patterns {
+			calendar := timex.NRFCalendar
+			calendar.Pattern = tt.pattern
+			calendar.LeapWeekMonth = tt.leapWeekMonth
+
+			week := 1
+			for month := 1; month <= 12; month++ {
+				start, err := calendar.MonthStart(2023, month)
+				assert.NoError(t, err)
+				end, err := calendar.MonthEnd(2023, month)
+				assert.NoError(t, err)
+				assert.Equal(t, tt.weeks[month-1]*7-1, end.Sub(start))
+
+				for d := start; !d.After(end); d = d.AddDays(1) {
+					year, quarter, m, w := calendar.Date(d)
+					assert.Equal(t, 2023, year)
+					assert.Equal(t, (month-1)/3+1, quarter)
+					assert.Equal(t, month, m)
+					assert.Equal(t, week+d.Sub(start)/7, w)
+				}
+				week += tt.weeks[month-1]
+			}
+			assert.Equal(t, 54, week)
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		_, err := timex.NRFCalendar.MonthStart(2024, 0)
+		assert.EqualError(t, err, "month is out of range [1,12]")
+		_, err = timex.NRFCalendar.MonthEnd(2024, 13)
+		assert.EqualError(t, err, "month is out of range [1,12]")
+	})
+
+	t.Run("Validate", func(t *testing.T) {
+		assert.NoError(t, timex.NRFCalendar.Validate())
+		assert.NoError(t, timex.RetailCalendar{}.Validate())
+
+		tests := []struct {
+			calendar  timex.RetailCalendar
+			errString string
+		}{
+			{timex.RetailCalendar{EndMonth: 13}, "end month is out of range [0,12]"},
+			{timex.RetailCalendar{EndMonth: -1}, "end month is out of range [0,12]"},
+			{timex.RetailCalendar{EndWeekday: 7}, "end weekday is out of range [0,6]"},
+			{timex.RetailCalendar{LeapWeekMonth: 13}, "leap week month is out of range [0,12]"},
+		}
+
+		for _, tt := range tests {
+			err := tt.calendar.Validate()
+			assert.EqualError(t, err, tt.errString)
+
+			var rangeErr *timex.RangeError
+			assert.ErrorAs(t, err, &rangeErr)
+
+			// The invalid fields are treated as zero.
+			assert.Equal(t, timex.RetailCalendar{}.YearEnd(2024), tt.calendar.YearEnd(2024))
+			assert.Equal(t, timex.RetailCalendar{}.Week(timex.MustNewDate(2024, 6, 1)), tt.calendar.Week(timex.MustNewDate(2024, 6, 1)))
+		}
+
+		unknown := []struct {
+			calendar  timex.RetailCalendar
+			errString string
+		}{
+			{timex.RetailCalendar{EndRule: 2}, "unknown retail year end rule 2"},
+			{timex.RetailCalendar{EndRule: -1}, "unknown retail year end rule -1"},
+			{timex.RetailCalendar{Pattern: 3}, "unknown retail pattern 3"},
+			{timex.RetailCalendar{Pattern: -1}, "unknown retail pattern -1"},
+		}
+
+		for _, tt := range unknown {
+			assert.EqualError(t, tt.calendar.Validate(), tt.errString)
+
+			// The invalid fields are treated as zero.
+			assert.Equal(t, timex.RetailCalendar{}.YearEnd(2024), tt.calendar.YearEnd(2024))
+			for month := 1; month <= 12; month++ {
+				want, _ := timex.RetailCalendar{}.MonthStart(2024, month)
+				start, err := tt.calendar.MonthStart(2024, month)
+				assert.NoError(t, err)
+				assert.Equal(t, want, start)
+			}
+		}
+	})
+}
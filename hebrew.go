@@ -0,0 +1,283 @@
+package timex
+
+import (
+	"errors"
+)
+
+var hebrewMonthLongNames = []string{
+	"Nisan",
+	"Iyar",
+	"Sivan",
+	"Tamuz",
+	"Av",
+	"Elul",
+	"Tishri",
+	"Heshvan",
+	"Kislev",
+	"Tevet",
+	"Shevat",
+	"Adar",
+	"Adar II",
+}
+
+var hebrewMonthHebrewNames = []string{
+	"ניסן",
+	"אייר",
+	"סיוון",
+	"תמוז",
+	"אב",
+	"אלול",
+	"תשרי",
+	"חשוון",
+	"כסלו",
+	"טבת",
+	"שבט",
+	"אדר",
+	"אדר ב׳",
+}
+
+// hebrewMonthNames returns the month names, month 12 is Adar I in a leap year.
+func hebrewMonthNames(hebrew, leap bool) dateNames {
+	names := hebrewMonthLongNames
+	if hebrew {
+		names = hebrewMonthHebrewNames
+	}
+	if leap {
+		names = append([]string(nil), names...)
+		if hebrew {
+			names[11] = "אדר א׳"
+		} else {
+			names[11] = "Adar I"
+		}
+	}
+	return dateNames{short: names, long: names}
+}
+
+// hebrewEpoch is the ordinal of Tishri 1 of year 1, which is October 7, 3761 BC in Julian calendar.
+const hebrewEpoch = -1373428
+
+// HebrewCalendar is the lunisolar Hebrew calendar.
+// The months are numbered from Nisan, which is month 1, to Adar, which is month 12,
+// and Adar II is month 13 in a leap year. The year starts on Tishri 1, which is month 7.
+type HebrewCalendar struct {
+	// HebrewNames reports whether the month names are formatted in Hebrew, otherwise transliterated.
+	HebrewNames bool
+}
+
+// IsLeapYear reports whether the year is a leap year, which has 13 months.
+func (c HebrewCalendar) IsLeapYear(year int) bool {
+	return floorMod(7*year+1, 19) < 7
+}
+
+// MonthsInYear returns the number of months in the year.
+func (c HebrewCalendar) MonthsInYear(year int) int {
+	if c.IsLeapYear(year) {
+		return 13
+	}
+	return 12
+}
+
+// elapsedDays returns days since the epoch to the molad of Tishri of the year, with the postponement of weekday.
+func (c HebrewCalendar) elapsedDays(year int) int {
+	months := floorDiv(235*year-234, 19)
+	parts := 12084 + 13753*months
+	days := 29*months + floorDiv(parts, 25920)
+	if floorMod(3*(days+1), 7) < 3 {
+		days++ // The new year does not fall on sunday, wednesday or friday.
+	}
+	return days
+}
+
+// newYear returns the ordinal of Tishri 1 of the year.
+func (c HebrewCalendar) newYear(year int) int {
+	ny0, ny1, ny2 := c.elapsedDays(year-1), c.elapsedDays(year), c.elapsedDays(year+1)
+	switch {
+	case ny2-ny1 == 356:
+		ny1 += 2 // The year is too long.
+	case ny1-ny0 == 382:
+		ny1++ // The previous year is too long.
+	}
+	return hebrewEpoch + ny1
+}
+
+// DaysInYear returns the number of days in the year, which is 353, 354, 355, 383, 384 or 385.
+func (c HebrewCalendar) DaysInYear(year int) int {
+	return c.newYear(year+1) - c.newYear(year)
+}
+
+// DaysInMonth returns the number of days in the month of the year.
+func (c HebrewCalendar) DaysInMonth(year, month int) int {
+	switch month {
+	case 2, 4, 6, 10, 13:
+		return 29
+	case 8: // Heshvan
+		if days := c.DaysInYear(year); days%10 != 5 {
+			return 29
+		}
+	case 9: // Kislev
+		if days := c.DaysInYear(year); days%10 == 3 {
+			return 29
+		}
+	case 12: // Adar
+		if !c.IsLeapYear(year) {
+			return 29
+		}
+	}
+	return 30
+}
+
+// ToOrdinal returns the ordinal of the year, month, and day in the calendar, see Calendar for the ordinal.
+func (c HebrewCalendar) ToOrdinal(year, month, day int) int {
+	n := c.newYear(year) + day - 1
+	if month < 7 {
+		for m := 7; m <= c.MonthsInYear(year); m++ {
+			n += c.DaysInMonth(year, m)
+		}
+		for m := 1; m < month; m++ {
+			n += c.DaysInMonth(year, m)
+		}
+	} else {
+		for m := 7; m < month; m++ {
+			n += c.DaysInMonth(year, m)
+		}
+	}
+	return n
+}
+
+// FromOrdinal returns the year, month, and day of the ordinal in the calendar, see Calendar for the ordinal.
+func (c HebrewCalendar) FromOrdinal(n int) (year, month, day int) {
+	// The average length of year is 35975351/98496 days.
+	year = floorDiv((n-hebrewEpoch)*98496, 35975351) + 1
+	for c.newYear(year) > n {
+		year--
+	}
+	for c.newYear(year+1) <= n {
+		year++
+	}
+
+	month = 7
+	if n < c.ToOrdinal(year, 1, 1) {
+		for n >= c.ToOrdinal(year, month, 1)+c.DaysInMonth(year, month) {
+			month++
+		}
+	} else {
+		month = 1
+		for n >= c.ToOrdinal(year, month, 1)+c.DaysInMonth(year, month) {
+			month++
+		}
+	}
+
+	return year, month, n - c.ToOrdinal(year, month, 1) + 1
+}
+
+// NewDate returns the date corresponding to year, month, and day in the calendar.
+func (c HebrewCalendar) NewDate(year, month, day int) (Date, error) {
+	if months := c.MonthsInYear(year); month < 1 || month > months {
+		return Date{}, rangeError(KindMonthOutOfRange, month, 1, months)
+	}
+
+	if days := c.DaysInMonth(year, month); day < 1 || day > days {
+		return Date{}, rangeError(KindDayOutOfRange, day, 1, days)
+	}
+
+	return Date{ordinal: c.ToOrdinal(year, month, day)}, nil
+}
+
+// MustNewDate is like NewDate but panics if the date cannot be created.
+func (c HebrewCalendar) MustNewDate(year, month, day int) Date {
+	date, err := c.NewDate(year, month, day)
+	if err != nil {
+		panic(`timex: HebrewCalendar.NewDate: ` + err.Error())
+	}
+	return date
+}
+
+// Date returns the year, month, and day specified by d in the calendar.
+func (c HebrewCalendar) Date(d Date) (year, month, day int) {
+	return c.FromOrdinal(d.ordinal)
+}
+
+// MonthName returns the name of the month in the year, month 12 is Adar I in a leap year.
+func (c HebrewCalendar) MonthName(year, month int) string {
+	return hebrewMonthNames(c.HebrewNames, c.IsLeapYear(year)).long[month-1]
+}
+
+// Parse parses a formatted string and returns the date it represents in the calendar.
+// The layout uses the tokens of ParseDate, and the month names are the Hebrew month names.
+func (c HebrewCalendar) Parse(layout, value string) (Date, error) {
+	f, err := parseDate(layout, value, elemYear|elemMonth|elemDay, hebrewMonthNames(c.HebrewNames, true))
+	if err != nil {
+		// Adar is named Adar I only in a leap year.
+		var err2 error
+		f, err2 = parseDate(layout, value, elemYear|elemMonth|elemDay, hebrewMonthNames(c.HebrewNames, false))
+		if err2 != nil {
+			return Date{}, err
+		}
+	}
+	date, err := c.NewDate(f.year, f.month, f.day)
+	return date, f.parseError(err)
+}
+
+// Format returns a textual representation of the date d in the calendar.
+// The layout uses the tokens of Date.Format, and the month names are the Hebrew month names.
+func (c HebrewCalendar) Format(d Date, layout string) string {
+	var f dateFields
+	f.year, f.month, f.day = c.Date(d)
+	bytes := make([]byte, 0, len(layout)+10)
+	bytes = appendDate(bytes, layout, elemYear|elemMonth|elemDay, hebrewMonthNames(c.HebrewNames, c.IsLeapYear(f.year)), f)
+	return string(bytes)
+}
+
+// HebrewHoliday is a major Jewish holiday.
+type HebrewHoliday int
+
+const (
+	HebrewHolidayRoshHashanah HebrewHoliday = iota + 1 // Tishri 1, the new year.
+	HebrewHolidayYomKippur                             // Tishri 10, the day of atonement.
+	HebrewHolidaySukkot                                // Tishri 15, the first day of the feast of tabernacles.
+	HebrewHolidayHanukkah                              // Kislev 25, the first day of the festival of lights.
+	HebrewHolidayPurim                                 // Adar 14, or Adar II 14 in a leap year.
+	HebrewHolidayPesach                                // Nisan 15, the first day of passover.
+	HebrewHolidayShavuot                               // Sivan 6, the feast of weeks.
+)
+
+var hebrewHolidayNames = []string{
+	"Rosh Hashanah",
+	"Yom Kippur",
+	"Sukkot",
+	"Hanukkah",
+	"Purim",
+	"Pesach",
+	"Shavuot",
+}
+
+// String returns the transliterated name of the holiday.
+func (h HebrewHoliday) String() string {
+	if h < HebrewHolidayRoshHashanah || h > HebrewHolidayShavuot {
+		return "%!HebrewHoliday(" + string(appendInt(nil, int(h), 0)) + ")"
+	}
+	return hebrewHolidayNames[h-1]
+}
+
+// Holiday returns the first day of the holiday in the year of Gregorian calendar.
+func (c HebrewCalendar) Holiday(year int, holiday HebrewHoliday) (Date, error) {
+	// Tishri of Hebrew year y+3761 is in the autumn of Gregorian year y.
+	switch holiday {
+	case HebrewHolidayRoshHashanah:
+		return Date{ordinal: c.ToOrdinal(year+3761, 7, 1)}, nil
+	case HebrewHolidayYomKippur:
+		return Date{ordinal: c.ToOrdinal(year+3761, 7, 10)}, nil
+	case HebrewHolidaySukkot:
+		return Date{ordinal: c.ToOrdinal(year+3761, 7, 15)}, nil
+	case HebrewHolidayHanukkah:
+		return Date{ordinal: c.ToOrdinal(year+3761, 9, 25)}, nil
+	case HebrewHolidayPurim:
+		return Date{ordinal: c.ToOrdinal(year+3760, c.MonthsInYear(year+3760), 14)}, nil
+	case HebrewHolidayPesach:
+		return Date{ordinal: c.ToOrdinal(year+3760, 1, 15)}, nil
+	case HebrewHolidayShavuot:
+		return Date{ordinal: c.ToOrdinal(year+3760, 3, 6)}, nil
+	default:
+		return Date{}, errors.New("unknown holiday")
+	}
+}
@@ -0,0 +1,169 @@
+package timex_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/invzhi/timex"
+)
+
+func TestHebrewCalendar(t *testing.T) {
+	tests := []struct {
+		year, month, day int
+		date             timex.Date
+	}{
+		{1, 7, 1, timex.MustDateFromJulianCalendar(-3760, 10, 7)},
+		{5706, 9, 7, timex.MustNewDate(1945, 11, 12)},
+		{5760, 10, 23, timex.MustNewDate(2000, 1, 1)},
+		{5784, 7, 1, timex.MustNewDate(2023, 9, 16)},
+		{5784, 12, 1, timex.MustNewDate(2024, 2, 10)},
+		{5784, 13, 14, timex.MustNewDate(2024, 3, 24)},
+		{5784, 1, 15, timex.MustNewDate(2024, 4, 23)},
+		{5784, 6, 29, timex.MustNewDate(2024, 10, 2)},
+		{5785, 7, 1, timex.MustNewDate(2024, 10, 3)},
+		{5785, 12, 14, timex.MustNewDate(2025, 3, 14)},
+	}
+
+	calendar := timex.HebrewCalendar{}
+	for _, tt := range tests {
+		assert.Equal(t, tt.date, calendar.MustNewDate(tt.year, tt.month, tt.day))
+
+		year, month, day := calendar.Date(tt.date)
+		assert.Equal(t, tt.year, year)
+		assert.Equal(t, tt.month, month)
+		assert.Equal(t, tt.day, day)
+	}
+
+	t.Run("Year", func(t *testing.T) {
+		tests := []struct {
+			year int
+			leap bool
+			days int
+		}{
+			{5783, false, 355},
+			{5784, true, 383},
+			{5785, false, 355},
+			{5786, false, 354},
+			{5787, true, 385},
+			{5790, true, 383},
+		}
+
+		for _, tt := range tests {
+			assert.Equal(t, tt.leap, calendar.IsLeapYear(tt.year))
+			assert.Equal(t, tt.days, calendar.DaysInYear(tt.year))
+
+			days := 0
+			for month := 1; month <= calendar.MonthsInYear(tt.year); month++ {
+				days += calendar.DaysInMonth(tt.year, month)
+			}
+			assert.Equal(t, tt.days, days)
+		}
+	})
+
+	t.Run("Continuous", func(t *testing.T) {
+		date := timex.MustNewDate(1900, 1, 1)
+		year, month, day := calendar.Date(date)
+		for date.Before(timex.MustNewDate(2100, 1, 1)) {
+			assert.Equal(t, date, calendar.MustNewDate(year, month, day))
+
+			date = date.AddDays(1)
+			y, m, d := calendar.Date(date)
+			if d == 1 {
+				assert.Equal(t, calendar.DaysInMonth(year, month), day)
+				if m == 7 {
+					assert.Equal(t, year+1, y)
+				}
+			} else {
+				assert.Equal(t, day+1, d)
+			}
+			year, month, day = y, m, d
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		tests := []struct {
+			year, month, day int
+			err              string
+		}{
+			{5785, 0, 1, "month is out of range [1,12]"},
+			{5785, 13, 1, "month is out of range [1,12]"},
+			{5784, 14, 1, "month is out of range [1,13]"},
+			{5785, 12, 30, "day is out of range [1,29]"},
+			{5786, 8, 30, "day is out of range [1,29]"},
+		}
+
+		for _, tt := range tests {
+			_, err := calendar.NewDate(tt.year, tt.month, tt.day)
+			assert.EqualError(t, err, tt.err)
+		}
+
+		assert.PanicsWithValue(t, "timex: HebrewCalendar.NewDate: day is out of range [1,29]", func() {
+			calendar.MustNewDate(5785, 12, 30)
+		})
+	})
+}
+
+func TestHebrewCalendarFormat(t *testing.T) {
+	tests := []struct {
+		calendar timex.HebrewCalendar
+		layout   string
+		date     timex.Date
+		value    string
+	}{
+		{timex.HebrewCalendar{}, "YYYY-MM-DD", timex.MustNewDate(2024, 10, 3), "5785-07-01"},
+		{timex.HebrewCalendar{}, "D MMMM YYYY", timex.MustNewDate(2024, 10, 3), "1 Tishri 5785"},
+		{timex.HebrewCalendar{}, "D MMMM YYYY", timex.MustNewDate(2024, 2, 23), "14 Adar I 5784"},
+		{timex.HebrewCalendar{}, "D MMMM YYYY", timex.MustNewDate(2024, 3, 24), "14 Adar II 5784"},
+		{timex.HebrewCalendar{}, "D MMM YYYY", timex.MustNewDate(2025, 3, 14), "14 Adar 5785"},
+		{timex.HebrewCalendar{HebrewNames: true}, "D MMMM YYYY", timex.MustNewDate(2024, 4, 23), "15 ניסן 5784"},
+		{timex.HebrewCalendar{HebrewNames: true}, "D MMMM YYYY", timex.MustNewDate(2024, 2, 23), "14 אדר א׳ 5784"},
+		{timex.HebrewCalendar{HebrewNames: true}, "D MMMM YYYY", timex.MustNewDate(2025, 3, 14), "14 אדר 5785"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.value, tt.calendar.Format(tt.date, tt.layout))
+
+		date, err := tt.calendar.Parse(tt.layout, tt.value)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.date, date)
+	}
+
+	_, err := timex.HebrewCalendar{}.Parse("D MMMM YYYY", "30 Adar 5785")
+	assert.EqualError(t, err, `parsing "30 Adar 5785" as "D MMMM YYYY": day is out of range [1,29]`)
+
+	_, err = timex.HebrewCalendar{}.Parse("D MMMM YYYY", "1 Adar III 5785")
+	assert.Error(t, err)
+}
+
+func TestHebrewHoliday(t *testing.T) {
+	tests := []struct {
+		year    int
+		holiday timex.HebrewHoliday
+		date    timex.Date
+	}{
+		{2024, timex.HebrewHolidayRoshHashanah, timex.MustNewDate(2024, 10, 3)},
+		{2024, timex.HebrewHolidayYomKippur, timex.MustNewDate(2024, 10, 12)},
+		{2024, timex.HebrewHolidaySukkot, timex.MustNewDate(2024, 10, 17)},
+		{2024, timex.HebrewHolidayHanukkah, timex.MustNewDate(2024, 12, 26)},
+		{2024, timex.HebrewHolidayPurim, timex.MustNewDate(2024, 3, 24)},
+		{2024, timex.HebrewHolidayPesach, timex.MustNewDate(2024, 4, 23)},
+		{2024, timex.HebrewHolidayShavuot, timex.MustNewDate(2024, 6, 12)},
+		{2025, timex.HebrewHolidayRoshHashanah, timex.MustNewDate(2025, 9, 23)},
+		{2025, timex.HebrewHolidayPurim, timex.MustNewDate(2025, 3, 14)},
+		{2025, timex.HebrewHolidayPesach, timex.MustNewDate(2025, 4, 13)},
+	}
+
+	for _, tt := range tests {
+		date, err := timex.HebrewCalendar{}.Holiday(tt.year, tt.holiday)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.date, date)
+	}
+
+	assert.Equal(t, "Rosh Hashanah", timex.HebrewHolidayRoshHashanah.String())
+	assert.Equal(t, "Shavuot", timex.HebrewHolidayShavuot.String())
+	assert.Equal(t, "%!HebrewHoliday(0)", timex.HebrewHoliday(0).String())
+
+	_, err := timex.HebrewCalendar{}.Holiday(2024, 0)
+	assert.EqualError(t, err, "unknown holiday")
+}
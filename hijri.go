@@ -0,0 +1,341 @@
+package timex
+
+var hijriMonthShortNames = []string{
+	"Muh.",
+	"Saf.",
+	"Rab. I",
+	"Rab. II",
+	"Jum. I",
+	"Jum. II",
+	"Raj.",
+	"Sha.",
+	"Ram.",
+	"Shaw.",
+	"Dhu'l-Q.",
+	"Dhu'l-H.",
+}
+
+var hijriMonthLongNames = []string{
+	"Muharram",
+	"Safar",
+	"Rabi' I",
+	"Rabi' II",
+	"Jumada I",
+	"Jumada II",
+	"Rajab",
+	"Sha'ban",
+	"Ramadan",
+	"Shawwal",
+	"Dhu'l-Qi'dah",
+	"Dhu'l-Hijjah",
+}
+
+var hijriMonthNames = dateNames{short: hijriMonthShortNames, long: hijriMonthLongNames}
+
+// HijriLeapPattern is the leap years in every 30 years of tabular Islamic calendar.
+type HijriLeapPattern int
+
+const (
+	// HijriLeapPattern16 has leap years 2, 5, 7, 10, 13, 16, 18, 21, 24, 26 and 29, it is the most common pattern.
+	HijriLeapPattern16 HijriLeapPattern = iota
+	// HijriLeapPattern15 has leap years 2, 5, 7, 10, 13, 15, 18, 21, 24, 26 and 29.
+	HijriLeapPattern15
+	// HijriLeapPatternFatimid has leap years 2, 5, 8, 10, 13, 16, 19, 21, 24, 27 and 29, it is used by Ismaili.
+	HijriLeapPatternFatimid
+	// HijriLeapPatternHabashAlHasib has leap years 2, 5, 8, 11, 13, 16, 19, 21, 24, 27 and 30.
+	HijriLeapPatternHabashAlHasib
+)
+
+// isLeap reports whether the year in the cycle of 30 years is a leap year.
+// Year y is a leap year if (11*y + c) mod 30 < 11, the constant c is specified by the pattern.
+func (p HijriLeapPattern) isLeap(year int) bool {
+	var c int
+	switch p {
+	case HijriLeapPattern15:
+		c = 15
+	case HijriLeapPatternFatimid:
+		c = 11
+	case HijriLeapPatternHabashAlHasib:
+		c = 9
+	default:
+		c = 14
+	}
+	_, y := norm1(0, year, 30)
+	return (11*y+c)%30 < 11
+}
+
+// HijriEpoch is the first day of tabular Islamic calendar.
+type HijriEpoch int
+
+const (
+	// HijriEpochCivil is the epoch on Friday, July 16, 622 in Julian calendar.
+	HijriEpochCivil HijriEpoch = iota
+	// HijriEpochAstronomical is the epoch on Thursday, July 15, 622 in Julian calendar.
+	HijriEpochAstronomical
+)
+
+func (e HijriEpoch) ordinal() int {
+	n := julianToOrdinal(622, 7, 16)
+	if e == HijriEpochAstronomical {
+		n--
+	}
+	return n
+}
+
+const (
+	daysEvery30HijriYears = 30*354 + 11
+)
+
+// TabularHijriCalendar is the arithmetic Islamic calendar, which has 30 or 29 days in each month alternately,
+// and 11 leap years in every 30 years, the last month of a leap year has 30 days.
+//
+// The zero value of type TabularHijriCalendar uses the most common leap pattern and the civil epoch.
+type TabularHijriCalendar struct {
+	LeapPattern HijriLeapPattern
+	Epoch       HijriEpoch
+}
+
+// IsLeapYear reports whether the year is a leap year.
+func (c TabularHijriCalendar) IsLeapYear(year int) bool {
+	return c.LeapPattern.isLeap(year)
+}
+
+// MonthsInYear returns the number of months in the year, which is 12.
+func (c TabularHijriCalendar) MonthsInYear(year int) int {
+	return 12
+}
+
+// MonthName returns the transliterated name of the month, such as Muharram.
+func (c TabularHijriCalendar) MonthName(year, month int) string {
+	return hijriMonthLongNames[month-1]
+}
+
+// MonthShortName returns the abbreviated transliterated name of the month, such as Muh.
+func (c TabularHijriCalendar) MonthShortName(year, month int) string {
+	return hijriMonthShortNames[month-1]
+}
+
+// DaysInMonth returns the number of days in the month of the year.
+func (c TabularHijriCalendar) DaysInMonth(year, month int) int {
+	if month%2 == 1 || month == 12 && c.IsLeapYear(year) {
+		return 30
+	}
+	return 29
+}
+
+// daysBeforeYear returns days since the epoch to the first day of the year.
+func (c TabularHijriCalendar) daysBeforeYear(year int) int {
+	n30, y := norm1(0, year, 30)
+	n := n30*daysEvery30HijriYears + (y-1)*354
+	for i := 1; i < y; i++ {
+		if c.IsLeapYear(i) {
+			n++
+		}
+	}
+	return n
+}
+
+// ToOrdinal returns the ordinal of the year, month, and day in the calendar, see Calendar for the ordinal.
+func (c TabularHijriCalendar) ToOrdinal(year, month, day int) int {
+	// The months before have 30 or 29 days alternately.
+	return c.Epoch.ordinal() + c.daysBeforeYear(year) + (month-1)*29 + month/2 + day - 1
+}
+
+// FromOrdinal returns the year, month, and day of the ordinal in the calendar, see Calendar for the ordinal.
+func (c TabularHijriCalendar) FromOrdinal(n int) (year, month, day int) {
+	n30, n := norm1(0, n-c.Epoch.ordinal()+1, daysEvery30HijriYears)
+	year = n30*30 + 1
+	for {
+		days := 354
+		if c.IsLeapYear(year) {
+			days++
+		}
+		if n <= days {
+			break
+		}
+		n -= days
+		year++
+	}
+
+	n2 := (n - 1) / 59 // Every two months have 59 days.
+	if n2 > 5 {
+		n2 = 5 // Handle the leap day at the end of year.
+	}
+	month = n2*2 + 1
+	n -= n2 * 59
+	if n > 30 {
+		month++
+		n -= 30
+	}
+	return year, month, n
+}
+
+// NewDate returns the date corresponding to year, month, and day in the calendar.
+func (c TabularHijriCalendar) NewDate(year, month, day int) (Date, error) {
+	if month < 1 || month > 12 {
+		return Date{}, rangeError(KindMonthOutOfRange, month, 1, 12)
+	}
+
+	if days := c.DaysInMonth(year, month); day < 1 || day > days {
+		return Date{}, rangeError(KindDayOutOfRange, day, 1, days)
+	}
+
+	return Date{ordinal: c.ToOrdinal(year, month, day)}, nil
+}
+
+// MustNewDate is like NewDate but panics if the date cannot be created.
+func (c TabularHijriCalendar) MustNewDate(year, month, day int) Date {
+	date, err := c.NewDate(year, month, day)
+	if err != nil {
+		panic(`timex: TabularHijriCalendar.NewDate: ` + err.Error())
+	}
+	return date
+}
+
+// Date returns the year, month, and day specified by d in the calendar.
+func (c TabularHijriCalendar) Date(d Date) (year, month, day int) {
+	return c.FromOrdinal(d.ordinal)
+}
+
+// Parse parses a formatted string and returns the date it represents in the calendar.
+// The layout uses the tokens of ParseDate, and the month names are the transliterated Hijri month names.
+func (c TabularHijriCalendar) Parse(layout, value string) (Date, error) {
+	f, err := parseDate(layout, value, elemYear|elemMonth|elemDay, hijriMonthNames)
+	if err != nil {
+		return Date{}, err
+	}
+	date, err := c.NewDate(f.year, f.month, f.day)
+	return date, f.parseError(err)
+}
+
+// Format returns a textual representation of the date d in the calendar.
+// The layout uses the tokens of Date.Format, and the month names are the transliterated Hijri month names.
+func (c TabularHijriCalendar) Format(d Date, layout string) string {
+	var f dateFields
+	f.year, f.month, f.day = c.Date(d)
+	bytes := make([]byte, 0, len(layout)+10)
+	bytes = appendDate(bytes, layout, elemYear|elemMonth|elemDay, hijriMonthNames, f)
+	return string(bytes)
+}
+
+const (
+	ummAlQuraMinYear = 1356
+	ummAlQuraMaxYear = 1500
+	ummAlQuraEpoch   = 707181 // March 14, 1937, the first day of year 1356.
+)
+
+// UmmAlQuraCalendar is the Islamic calendar used in Saudi Arabia, which is based on astronomical calculation.
+// It is supported from year 1356 to 1500 (March 14, 1937 to November 16, 2077) by an embedded table,
+// dates out of the range fall back to the zero value of TabularHijriCalendar.
+type UmmAlQuraCalendar struct{}
+
+// inRange reports whether the year is in the range of embedded table.
+func (c UmmAlQuraCalendar) inRange(year int) bool {
+	return year >= ummAlQuraMinYear && year <= ummAlQuraMaxYear
+}
+
+// MonthsInYear returns the number of months in the year, which is 12.
+func (c UmmAlQuraCalendar) MonthsInYear(year int) int {
+	return 12
+}
+
+// DaysInMonth returns the number of days in the month of the year.
+func (c UmmAlQuraCalendar) DaysInMonth(year, month int) int {
+	if !c.inRange(year) {
+		return TabularHijriCalendar{}.DaysInMonth(year, month)
+	}
+	i := (year-ummAlQuraMinYear)*12 + month - 1
+	return int(ummAlQuraMonthStarts[i+1] - ummAlQuraMonthStarts[i])
+}
+
+// MonthName returns the transliterated name of the month, such as Muharram.
+func (c UmmAlQuraCalendar) MonthName(year, month int) string {
+	return hijriMonthLongNames[month-1]
+}
+
+// MonthShortName returns the abbreviated transliterated name of the month, such as Muh.
+func (c UmmAlQuraCalendar) MonthShortName(year, month int) string {
+	return hijriMonthShortNames[month-1]
+}
+
+// ToOrdinal returns the ordinal of the year, month, and day in the calendar, see Calendar for the ordinal.
+func (c UmmAlQuraCalendar) ToOrdinal(year, month, day int) int {
+	if !c.inRange(year) {
+		return TabularHijriCalendar{}.ToOrdinal(year, month, day)
+	}
+	i := (year-ummAlQuraMinYear)*12 + month - 1
+	return ummAlQuraEpoch + int(ummAlQuraMonthStarts[i]) + day - 1
+}
+
+// FromOrdinal returns the year, month, and day of the ordinal in the calendar, see Calendar for the ordinal.
+func (c UmmAlQuraCalendar) FromOrdinal(n int) (year, month, day int) {
+	n -= ummAlQuraEpoch
+	if n < 0 || n >= int(ummAlQuraMonthStarts[len(ummAlQuraMonthStarts)-1]) {
+		return TabularHijriCalendar{}.FromOrdinal(n + ummAlQuraEpoch)
+	}
+
+	// Binary search the last month which starts not after n.
+	lo, hi := 0, len(ummAlQuraMonthStarts)-1
+	for lo < hi {
+		mid := (lo + hi + 1) / 2
+		if int(ummAlQuraMonthStarts[mid]) <= n {
+			lo = mid
+		} else {
+			hi = mid - 1
+		}
+	}
+
+	return ummAlQuraMinYear + lo/12, lo%12 + 1, n - int(ummAlQuraMonthStarts[lo]) + 1
+}
+
+// NewDate returns the date corresponding to year, month, and day in the calendar.
+func (c UmmAlQuraCalendar) NewDate(year, month, day int) (Date, error) {
+	if !c.inRange(year) {
+		return TabularHijriCalendar{}.NewDate(year, month, day)
+	}
+
+	if month < 1 || month > 12 {
+		return Date{}, rangeError(KindMonthOutOfRange, month, 1, 12)
+	}
+
+	if days := c.DaysInMonth(year, month); day < 1 || day > days {
+		return Date{}, rangeError(KindDayOutOfRange, day, 1, days)
+	}
+
+	return Date{ordinal: c.ToOrdinal(year, month, day)}, nil
+}
+
+// MustNewDate is like NewDate but panics if the date cannot be created.
+func (c UmmAlQuraCalendar) MustNewDate(year, month, day int) Date {
+	date, err := c.NewDate(year, month, day)
+	if err != nil {
+		panic(`timex: UmmAlQuraCalendar.NewDate: ` + err.Error())
+	}
+	return date
+}
+
+// Date returns the year, month, and day specified by d in the calendar.
+func (c UmmAlQuraCalendar) Date(d Date) (year, month, day int) {
+	return c.FromOrdinal(d.ordinal)
+}
+
+// Parse parses a formatted string and returns the date it represents in the calendar.
+// The layout uses the tokens of ParseDate, and the month names are the transliterated Hijri month names.
+func (c UmmAlQuraCalendar) Parse(layout, value string) (Date, error) {
+	f, err := parseDate(layout, value, elemYear|elemMonth|elemDay, hijriMonthNames)
+	if err != nil {
+		return Date{}, err
+	}
+	date, err := c.NewDate(f.year, f.month, f.day)
+	return date, f.parseError(err)
+}
+
+// Format returns a textual representation of the date d in the calendar.
+// The layout uses the tokens of Date.Format, and the month names are the transliterated Hijri month names.
+func (c UmmAlQuraCalendar) Format(d Date, layout string) string {
+	var f dateFields
+	f.year, f.month, f.day = c.Date(d)
+	bytes := make([]byte, 0, len(layout)+10)
+	bytes = appendDate(bytes, layout, elemYear|elemMonth|elemDay, hijriMonthNames, f)
+	return string(bytes)
+}
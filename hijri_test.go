@@ -0,0 +1,195 @@
+package timex_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/invzhi/timex"
+)
+
+func TestTabularHijriCalendar(t *testing.T) {
+	tests := []struct {
+		calendar         timex.TabularHijriCalendar
+		year, month, day int
+		date             timex.Date
+	}{
+		{timex.TabularHijriCalendar{}, 1, 1, 1, timex.MustNewDate(622, 7, 19)},
+		{timex.TabularHijriCalendar{Epoch: timex.HijriEpochAstronomical}, 1, 1, 1, timex.MustNewDate(622, 7, 18)},
+		{timex.TabularHijriCalendar{}, 1420, 9, 24, timex.MustNewDate(2000, 1, 1)},
+		{timex.TabularHijriCalendar{}, 1444, 12, 29, timex.MustNewDate(2023, 7, 18)},
+		{timex.TabularHijriCalendar{}, 1445, 1, 1, timex.MustNewDate(2023, 7, 19)},
+		{timex.TabularHijriCalendar{}, 1445, 9, 1, timex.MustNewDate(2024, 3, 11)},
+		{timex.TabularHijriCalendar{}, 1445, 12, 30, timex.MustNewDate(2024, 7, 7)},
+		{timex.TabularHijriCalendar{Epoch: timex.HijriEpochAstronomical}, 1445, 9, 2, timex.MustNewDate(2024, 3, 11)},
+		{timex.TabularHijriCalendar{LeapPattern: timex.HijriLeapPattern15}, 1425, 12, 30, timex.MustNewDate(2005, 2, 10)},
+		{timex.TabularHijriCalendar{LeapPattern: timex.HijriLeapPattern16}, 1426, 1, 1, timex.MustNewDate(2005, 2, 10)},
+		{timex.TabularHijriCalendar{LeapPattern: timex.HijriLeapPatternHabashAlHasib}, 1440, 12, 30, timex.MustNewDate(2019, 8, 31)},
+		{timex.TabularHijriCalendar{}, 0, 12, 29, timex.MustNewDate(622, 7, 18)},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.date, tt.calendar.MustNewDate(tt.year, tt.month, tt.day))
+
+		year, month, day := tt.calendar.Date(tt.date)
+		assert.Equal(t, tt.year, year)
+		assert.Equal(t, tt.month, month)
+		assert.Equal(t, tt.day, day)
+	}
+
+	t.Run("LeapYear", func(t *testing.T) {
+		patterns := []struct {
+			pattern timex.HijriLeapPattern
+			years   []int
+		}{
+			{timex.HijriLeapPattern16, []int{2, 5, 7, 10, 13, 16, 18, 21, 24, 26, 29}},
+			{timex.HijriLeapPattern15, []int{2, 5, 7, 10, 13, 15, 18, 21, 24, 26, 29}},
+			{timex.HijriLeapPatternFatimid, []int{2, 5, 8, 10, 13, 16, 19, 21, 24, 27, 29}},
+			{timex.HijriLeapPatternHabashAlHasib, []int{2, 5, 8, 11, 13, 16, 19, 21, 24, 27, 30}},
+		}
+
+		for _, tt := range patterns {
+			calendar := timex.TabularHijriCalendar{LeapPattern: tt.pattern}
+
+			var years []int
+			for year := 1441; year <= 1470; year++ {
+				if calendar.IsLeapYear(year) {
+					years = append(years, year-1440)
+				}
+				days := 0
+				for month := 1; month <= 12; month++ {
+					days += calendar.DaysInMonth(year, month)
+				}
+				next := calendar.MustNewDate(year+1, 1, 1)
+				assert.Equal(t, days, next.Sub(calendar.MustNewDate(year, 1, 1)))
+			}
+			assert.Equal(t, tt.years, years)
+		}
+	})
+
+	t.Run("Continuous", func(t *testing.T) {
+		calendar := timex.TabularHijriCalendar{LeapPattern: timex.HijriLeapPatternFatimid}
+		date := timex.MustNewDate(-100, 1, 1)
+		for n := 0; n < 50000; n++ {
+			year, month, day := calendar.Date(date)
+			assert.Equal(t, date, calendar.MustNewDate(year, month, day))
+			date = date.AddDays(37)
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		tests := []struct {
+			year, month, day int
+			err              string
+		}{
+			{1445, 0, 1, "month is out of range [1,12]"},
+			{1445, 13, 1, "month is out of range [1,12]"},
+			{1445, 2, 30, "day is out of range [1,29]"},
+			{1444, 12, 30, "day is out of range [1,29]"},
+			{1445, 1, 31, "day is out of range [1,30]"},
+		}
+
+		for _, tt := range tests {
+			_, err := timex.TabularHijriCalendar{}.NewDate(tt.year, tt.month, tt.day)
+			assert.EqualError(t, err, tt.err)
+		}
+
+		assert.PanicsWithValue(t, "timex: TabularHijriCalendar.NewDate: day is out of range [1,29]", func() {
+			timex.TabularHijriCalendar{}.MustNewDate(1444, 12, 30)
+		})
+	})
+}
+
+func TestUmmAlQuraCalendar(t *testing.T) {
+	tests := []struct {
+		year, month, day int
+		date             timex.Date
+	}{
+		{1355, 12, 30, timex.MustNewDate(1937, 3, 13)},
+		{1356, 1, 1, timex.MustNewDate(1937, 3, 14)},
+		{1364, 8, 28, timex.MustNewDate(1945, 8, 7)},
+		{1364, 9, 1, timex.MustNewDate(1945, 8, 8)},
+		{1420, 9, 24, timex.MustNewDate(2000, 1, 1)},
+		{1445, 1, 1, timex.MustNewDate(2023, 7, 19)},
+		{1445, 9, 1, timex.MustNewDate(2024, 3, 11)},
+		{1446, 1, 1, timex.MustNewDate(2024, 7, 7)},
+		{1451, 8, 26, timex.MustNewDate(2030, 1, 1)},
+		{1500, 12, 30, timex.MustNewDate(2077, 11, 16)},
+		{1501, 1, 1, timex.MustNewDate(2077, 11, 17)},
+	}
+
+	calendar := timex.UmmAlQuraCalendar{}
+	for _, tt := range tests {
+		assert.Equal(t, tt.date, calendar.MustNewDate(tt.year, tt.month, tt.day))
+
+		year, month, day := calendar.Date(tt.date)
+		assert.Equal(t, tt.year, year)
+		assert.Equal(t, tt.month, month)
+		assert.Equal(t, tt.day, day)
+	}
+
+	assert.Equal(t, 29, calendar.DaysInMonth(1445, 8))
+	assert.Equal(t, 30, calendar.DaysInMonth(1445, 9))
+
+	t.Run("Continuous", func(t *testing.T) {
+		date := timex.MustNewDate(1937, 1, 1)
+		year, month, day := calendar.Date(date)
+		for date.Before(timex.MustNewDate(2078, 1, 1)) {
+			assert.Equal(t, date, calendar.MustNewDate(year, month, day))
+
+			date = date.AddDays(1)
+			y, m, d := calendar.Date(date)
+			if d == 1 {
+				assert.Equal(t, calendar.DaysInMonth(year, month), day)
+				assert.Equal(t, month%12+1, m)
+			} else {
+				assert.Equal(t, day+1, d)
+			}
+			year, month, day = y, m, d
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		_, err := calendar.NewDate(1445, 8, 30)
+		assert.EqualError(t, err, "day is out of range [1,29]")
+
+		_, err = calendar.NewDate(1445, 13, 1)
+		assert.EqualError(t, err, "month is out of range [1,12]")
+
+		assert.PanicsWithValue(t, "timex: UmmAlQuraCalendar.NewDate: day is out of range [1,29]", func() {
+			calendar.MustNewDate(1445, 8, 30)
+		})
+	})
+}
+
+func TestHijriCalendarFormat(t *testing.T) {
+	tests := []struct {
+		layout string
+		date   timex.Date
+		value  string
+	}{
+		{"YYYY-MM-DD", timex.MustNewDate(2024, 3, 11), "1445-09-01"},
+		{"D MMMM YYYY", timex.MustNewDate(2024, 3, 11), "1 Ramadan 1445"},
+		{"D MMMM YYYY", timex.MustNewDate(2023, 10, 16), "1 Rabi' II 1445"},
+		{"D MMMM YYYY", timex.MustNewDate(2023, 9, 16), "1 Rabi' I 1445"},
+		{"DD MMM YYYY", timex.MustNewDate(2024, 6, 7), "01 Dhu'l-H. 1445"},
+		{"DD MMM YYYY", timex.MustNewDate(2024, 1, 13), "01 Raj. 1445"},
+	}
+
+	for _, tt := range tests {
+		calendar := timex.UmmAlQuraCalendar{}
+		assert.Equal(t, tt.value, calendar.Format(tt.date, tt.layout))
+
+		date, err := calendar.Parse(tt.layout, tt.value)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.date, date)
+	}
+
+	date, err := timex.TabularHijriCalendar{}.Parse("D MMMM YYYY", "30 Dhu'l-Hijjah 1445")
+	assert.NoError(t, err)
+	assert.Equal(t, timex.MustNewDate(2024, 7, 7), date)
+	assert.Equal(t, "1445-12-30", timex.TabularHijriCalendar{}.Format(date, "YYYY-MM-DD"))
+
+	_, err = timex.TabularHijriCalendar{}.Parse("D MMMM YYYY", "30 Dhu'l-Hijjah 1444")
+	assert.EqualError(t, err, `parsing "30 Dhu'l-Hijjah 1444" as "D MMMM YYYY": day is out of range [1,29]`)
+}
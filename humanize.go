@@ -0,0 +1,233 @@
+package timex
+
+import (
+	"fmt"
+	"time"
+)
+
+// HumanizeUnit is a unit of humanized differences, such as the day of "in 3 days".
+type HumanizeUnit int
+
+// The units of humanized differences.
+const (
+	HumanizeSecond HumanizeUnit = iota + 1
+	HumanizeMinute
+	HumanizeHour
+	HumanizeDay
+	HumanizeWeek
+	HumanizeMonth
+	HumanizeYear
+)
+
+// HumanizeLocale is the words of a language in which differences are humanized.
+type HumanizeLocale struct {
+	// Today, Tomorrow and Yesterday are the phrases of dates 0, 1 and -1 days after the reference date.
+	Today, Tomorrow, Yesterday string
+	// Now is the phrase of durations which are rounded to zero seconds.
+	Now string
+	// Future and Past are the formats of differences after and before the reference, such as "in %s" and "%s ago".
+	Future, Past string
+	// Next and Last are the phrases of the next and last calendar week, month or year, such as "next week".
+	// If the phrase of a unit is missing, the difference of 1 unit is in Future or Past.
+	Next, Last map[HumanizeUnit]string
+	// Units is the plural forms of the units, which are formatted with the number by %d, such as "%d days".
+	Units map[HumanizeUnit][]string
+	// Plural returns the index of the plural form of the number n in Units.
+	// If it is nil, the first form is for 1 and the second form is for other numbers.
+	Plural func(n int) int
+	// WeekStart is the first day of a calendar week.
+	WeekStart time.Weekday
+}
+
+// EnglishHumanizeLocale is the locale of English humanized differences.
+var EnglishHumanizeLocale = HumanizeLocale{
+	Today:     "today",
+	Tomorrow:  "tomorrow",
+	Yesterday: "yesterday",
+	Now:       "now",
+	Future:    "in %s",
+	Past:      "%s ago",
+	Next: map[HumanizeUnit]string{
+		HumanizeWeek:  "next week",
+		HumanizeMonth: "next month",
+		HumanizeYear:  "next year",
+	},
+	Last: map[HumanizeUnit]string{
+		HumanizeWeek:  "last week",
+		HumanizeMonth: "last month",
+		HumanizeYear:  "last year",
+	},
+	Units: map[HumanizeUnit][]string{
+		HumanizeSecond: {"%d second", "%d seconds"},
+		HumanizeMinute: {"%d minute", "%d minutes"},
+		HumanizeHour:   {"%d hour", "%d hours"},
+		HumanizeDay:    {"%d day", "%d days"},
+		HumanizeWeek:   {"%d week", "%d weeks"},
+		HumanizeMonth:  {"%d month", "%d months"},
+		HumanizeYear:   {"%d year", "%d years"},
+	},
+	WeekStart: time.Monday,
+}
+
+// HumanizeThresholds is the thresholds below which a difference is humanized in a unit,
+// otherwise it is humanized in the next larger unit unless the difference in that unit is zero.
+type HumanizeThresholds struct {
+	// Seconds is the number of seconds below which a duration is in seconds.
+	Seconds int
+	// Minutes is the number of minutes below which a duration is in minutes.
+	Minutes int
+	// Hours is the number of hours below which a duration is in hours, otherwise it is in days.
+	Hours int
+	// Days is the number of days below which a date difference is in days.
+	Days int
+	// Weeks is the number of calendar weeks below which a date difference is in weeks.
+	Weeks int
+	// Months is the number of calendar months below which a date difference is in months, otherwise it is in years.
+	Months int
+}
+
+// DefaultHumanizeThresholds is the thresholds of DefaultHumanizer.
+var DefaultHumanizeThresholds = HumanizeThresholds{
+	Seconds: 45,
+	Minutes: 60,
+	Hours:   22,
+	Days:    7,
+	Weeks:   4,
+	Months:  12,
+}
+
+// Humanizer humanizes the differences of dates and durations in a locale.
+type Humanizer struct {
+	Locale     HumanizeLocale
+	Thresholds HumanizeThresholds
+}
+
+// DefaultHumanizer is the humanizer used by Date.Humanize and TimeOfDay.Humanize.
+var DefaultHumanizer = Humanizer{Locale: EnglishHumanizeLocale, Thresholds: DefaultHumanizeThresholds}
+
+// Humanize returns the difference from ref to d in English, such as "today", "in 3 days", "last week" and "2 months ago".
+// It uses DefaultHumanizer, see Humanizer.Date.
+func (d Date) Humanize(ref Date) string {
+	return DefaultHumanizer.Date(d, ref)
+}
+
+// Humanize returns the duration from ref to t in English, such as "now", "in 45 minutes" and "2 hours ago".
+// It uses DefaultHumanizer, see Humanizer.Duration.
+func (t TimeOfDay) Humanize(ref TimeOfDay) string {
+	return DefaultHumanizer.Duration(t.Sub(ref))
+}
+
+// Date returns the difference from ref to d. The difference is in days if it is less than the threshold of days,
+// otherwise it is the difference of calendar weeks, months or years, so 8 days after a Wednesday is next week.
+func (h Humanizer) Date(d, ref Date) string {
+	days := d.Sub(ref)
+	switch days {
+	case 0:
+		return h.Locale.Today
+	case 1:
+		return h.Locale.Tomorrow
+	case -1:
+		return h.Locale.Yesterday
+	}
+	if abs(days) < h.Thresholds.Days {
+		return h.format(days, HumanizeDay)
+	}
+
+	// The difference in a larger unit is used only if it is not zero.
+	weeks := d.StartOfWeek(h.Locale.WeekStart).Sub(ref.StartOfWeek(h.Locale.WeekStart)) / 7
+	months := YearMonthOf(d).Sub(YearMonthOf(ref))
+	years := d.Year() - ref.Year()
+	switch {
+	case abs(weeks) < h.Thresholds.Weeks || months == 0:
+		return h.format(nonZero(weeks, days), HumanizeWeek)
+	case abs(months) < h.Thresholds.Months || years == 0:
+		return h.format(months, HumanizeMonth)
+	default:
+		return h.format(years, HumanizeYear)
+	}
+}
+
+// Duration returns the duration d relative to now, which is rounded to the nearest unit,
+// such as "in 45 minutes" and "2 hours ago".
+func (h Humanizer) Duration(d time.Duration) string {
+	sign := 1
+	if d < 0 {
+		d, sign = -d, -1
+	}
+	if d < time.Second/2 {
+		return h.Locale.Now
+	}
+
+	units := [...]struct {
+		unit      HumanizeUnit
+		d         time.Duration
+		threshold int
+	}{
+		{HumanizeSecond, time.Second, h.Thresholds.Seconds},
+		{HumanizeMinute, time.Minute, h.Thresholds.Minutes},
+		{HumanizeHour, time.Hour, h.Thresholds.Hours},
+	}
+	for _, u := range units {
+		if n := int((d + u.d/2) / u.d); n < u.threshold {
+			return h.format(sign*nonZero(n, 1), u.unit)
+		}
+	}
+
+	n := int((d + 12*time.Hour) / (24 * time.Hour))
+	return h.format(sign*nonZero(n, 1), HumanizeDay)
+}
+
+// format returns the phrase of n units, n is not zero.
+func (h Humanizer) format(n int, unit HumanizeUnit) string {
+	switch {
+	case n == 1 && h.Locale.Next[unit] != "":
+		return h.Locale.Next[unit]
+	case n == -1 && h.Locale.Last[unit] != "":
+		return h.Locale.Last[unit]
+	}
+
+	format := h.Locale.Future
+	if n < 0 {
+		format, n = h.Locale.Past, -n
+	}
+	return fmt.Sprintf(format, h.unit(n, unit))
+}
+
+// unit returns n units in the plural form.
+func (h Humanizer) unit(n int, unit HumanizeUnit) string {
+	forms := h.Locale.Units[unit]
+	if len(forms) == 0 {
+		return fmt.Sprint(n)
+	}
+
+	i := 0
+	switch {
+	case h.Locale.Plural != nil:
+		i = h.Locale.Plural(n)
+	case n != 1:
+		i = 1
+	}
+	if i < 0 || i >= len(forms) {
+		i = len(forms) - 1
+	}
+	return fmt.Sprintf(forms[i], n)
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// nonZero returns n, or 1 with the sign of sign if n is zero.
+func nonZero(n, sign int) int {
+	switch {
+	case n != 0:
+		return n
+	case sign < 0:
+		return -1
+	default:
+		return 1
+	}
+}
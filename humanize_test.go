@@ -0,0 +1,106 @@
+package timex_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/invzhi/timex"
+)
+
+func TestDate_Humanize(t *testing.T) {
+	ref := timex.MustNewDate(2024, 1, 31) // Wednesday.
+
+	tests := []struct {
+		date  timex.Date
+		value string
+	}{
+		{ref, "today"},
+		{timex.MustNewDate(2024, 2, 1), "tomorrow"},
+		{timex.MustNewDate(2024, 1, 30), "yesterday"},
+		{timex.MustNewDate(2024, 2, 3), "in 3 days"},
+		{timex.MustNewDate(2024, 1, 25), "6 days ago"},
+		{timex.MustNewDate(2024, 2, 7), "next week"},
+		{timex.MustNewDate(2024, 1, 24), "last week"},
+		{timex.MustNewDate(2024, 2, 12), "in 2 weeks"},
+		{timex.MustNewDate(2024, 1, 7), "4 weeks ago"},
+		{timex.MustNewDate(2024, 2, 29), "next month"},
+		{timex.MustNewDate(2024, 3, 1), "in 2 months"},
+		{timex.MustNewDate(2023, 11, 30), "2 months ago"},
+		{timex.MustNewDate(2024, 12, 31), "in 11 months"},
+		{timex.MustNewDate(2025, 1, 1), "next year"},
+		{timex.MustNewDate(2021, 6, 1), "3 years ago"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.value, tt.date.Humanize(ref), tt.date.String())
+	}
+
+	t.Run("Humanizer", func(t *testing.T) {
+		humanizer := timex.Humanizer{
+			Locale: timex.HumanizeLocale{
+				Today:    "сегодня",
+				Tomorrow: "завтра",
+				Future:   "через %s",
+				Past:     "%s назад",
+				Units: map[timex.HumanizeUnit][]string{
+					timex.HumanizeDay:   {"%d день", "%d дня", "%d дней"},
+					timex.HumanizeMonth: {"%d месяц", "%d месяца", "%d месяцев"},
+				},
+				Plural: func(n int) int {
+					switch {
+					case n%10 == 1 && n%100 != 11:
+						return 0
+					case n%10 >= 2 && n%10 <= 4 && (n%100 < 10 || n%100 >= 20):
+						return 1
+					default:
+						return 2
+					}
+				},
+			},
+			Thresholds: timex.HumanizeThresholds{Days: 30, Months: 12},
+		}
+
+		tests := []struct {
+			date  timex.Date
+			value string
+		}{
+			{timex.MustNewDate(2024, 2, 1), "завтра"},
+			{timex.MustNewDate(2024, 2, 3), "через 3 дня"},
+			{timex.MustNewDate(2024, 2, 21), "через 21 день"},
+			{timex.MustNewDate(2024, 1, 6), "25 дней назад"},
+			{timex.MustNewDate(2024, 3, 31), "через 2 месяца"},
+			{timex.MustNewDate(2023, 7, 1), "6 месяцев назад"},
+		}
+
+		for _, tt := range tests {
+			assert.Equal(t, tt.value, humanizer.Date(tt.date, ref), tt.date.String())
+		}
+	})
+}
+
+func TestTimeOfDay_Humanize(t *testing.T) {
+	ref := timex.MustNewTimeOfDay(12, 0, 0, 0)
+
+	tests := []struct {
+		t     timex.TimeOfDay
+		value string
+	}{
+		{ref, "now"},
+		{timex.MustNewTimeOfDay(12, 0, 30, 0), "in 30 seconds"},
+		{timex.MustNewTimeOfDay(11, 59, 59, 0), "1 second ago"},
+		{timex.MustNewTimeOfDay(12, 0, 50, 0), "in 1 minute"},
+		{timex.MustNewTimeOfDay(12, 45, 0, 0), "in 45 minutes"},
+		{timex.MustNewTimeOfDay(11, 20, 0, 0), "40 minutes ago"},
+		{timex.MustNewTimeOfDay(10, 0, 0, 0), "2 hours ago"},
+		{timex.MustNewTimeOfDay(23, 59, 0, 0), "in 12 hours"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.value, tt.t.Humanize(ref), tt.t.String())
+	}
+
+	assert.Equal(t, "in 2 days", timex.DefaultHumanizer.Duration(47*time.Hour))
+	assert.Equal(t, "in 1 day", timex.DefaultHumanizer.Duration(23*time.Hour))
+}
@@ -0,0 +1,167 @@
+package timex
+
+// julianEpoch is the ordinal of January 1 of year 1 in Julian calendar, which is December 30 of year 0 in Gregorian calendar.
+const julianEpoch = -2
+
+const daysEvery4JulianYears = 4*365 + 1
+
+func isJulianLeap(year int) bool {
+	return year%4 == 0
+}
+
+// daysInJulianMonth returns the number of days in the specified month of Julian calendar.
+func daysInJulianMonth(year, month int) int {
+	if month == 2 && isJulianLeap(year) {
+		return 29
+	}
+	return int(daysInYear[month] - daysInYear[month-1])
+}
+
+func julianToOrdinal(year, month, day int) int {
+	n4, y := norm1(0, year, 4) // Year 1 to 4 is a cycle, and year 4 is a leap year.
+	n := n4*daysEvery4JulianYears + (y-1)*365 + int(daysInYear[month-1]) + day - 1
+	if month > 2 && isJulianLeap(year) {
+		n++
+	}
+	return n + julianEpoch
+}
+
+func ordinalToJulian(n int) (year, month, day int) {
+	n4, n := norm1(0, n-julianEpoch+1, daysEvery4JulianYears)
+	n1 := (n - 1) / 365
+	n1 -= n1 >> 2 // Handle the leap day every 4 years. If n1 is 4, set it to 3.
+	year = n4*4 + n1 + 1
+	day = n - 365*n1
+
+	for month = 1; month < 12; month++ {
+		days := daysInJulianMonth(year, month)
+		if day <= days {
+			break
+		}
+		day -= days
+	}
+	return year, month, day
+}
+
+// DateFromJulianCalendar returns the date corresponding to year, month, and day in proleptic Julian calendar.
+func DateFromJulianCalendar(year, month, day int) (Date, error) {
+	if month < 1 || month > 12 {
+		return Date{}, rangeError(KindMonthOutOfRange, month, 1, 12)
+	}
+
+	if days := daysInJulianMonth(year, month); day < 1 || day > days {
+		return Date{}, rangeError(KindDayOutOfRange, day, 1, days)
+	}
+
+	return Date{ordinal: julianToOrdinal(year, month, day)}, nil
+}
+
+// MustDateFromJulianCalendar is like DateFromJulianCalendar but panics if the date cannot be created.
+func MustDateFromJulianCalendar(year, month, day int) Date {
+	date, err := DateFromJulianCalendar(year, month, day)
+	if err != nil {
+		panic(`timex: DateFromJulianCalendar: ` + err.Error())
+	}
+	return date
+}
+
+// JulianCalendar returns the year, month, and day specified by d in proleptic Julian calendar.
+func (d Date) JulianCalendar() (year, month, day int) {
+	return ordinalToJulian(d.ordinal)
+}
+
+// HybridCalendar is the calendar which uses Julian calendar before the cutover and Gregorian calendar since then,
+// dates are represented as people of the time wrote them.
+//
+// The zero value of type HybridCalendar has the cutover on October 15, 1582.
+type HybridCalendar struct {
+	// Cutover is the first date in Gregorian calendar, zero means October 15, 1582.
+	Cutover Date
+}
+
+var (
+	// GregorianCutover is the hybrid calendar which adopts Gregorian calendar on October 15, 1582,
+	// the day after October 4, 1582 in Julian calendar.
+	GregorianCutover = HybridCalendar{Cutover: Date{ordinal: lilianEpoch + 1}}
+	// BritishCutover is the hybrid calendar which adopts Gregorian calendar on September 14, 1752 in Great Britain and its colonies,
+	// the day after September 2, 1752 in Julian calendar.
+	BritishCutover = HybridCalendar{Cutover: Date{ordinal: 639796}}
+)
+
+func (c HybridCalendar) cutover() Date {
+	if c.Cutover.IsZero() {
+		return GregorianCutover.Cutover
+	}
+	return c.Cutover
+}
+
+// IsGregorian reports whether the date d is in Gregorian calendar, which is not before the cutover.
+func (c HybridCalendar) IsGregorian(d Date) bool {
+	return !d.Before(c.cutover())
+}
+
+// Date returns the year, month, and day specified by d in the hybrid calendar.
+func (c HybridCalendar) Date(d Date) (year, month, day int) {
+	if c.IsGregorian(d) {
+		return ordinalToCalendar(d.ordinal)
+	}
+	return ordinalToJulian(d.ordinal)
+}
+
+// NewDate returns the date corresponding to year, month, and day in the hybrid calendar.
+// The dates skipped by the cutover are invalid, and ErrSkippedDate is returned for them.
+func (c HybridCalendar) NewDate(year, month, day int) (Date, error) {
+	cutover := c.cutover()
+	if y, m, d := cutover.Date(); year > y || year == y && (month > m || month == m && day >= d) {
+		date, err := NewDate(year, month, day)
+		if err != nil {
+			return Date{}, err
+		}
+		if date.Before(cutover) {
+			return Date{}, ErrSkippedDate
+		}
+		return date, nil
+	}
+
+	date, err := DateFromJulianCalendar(year, month, day)
+	if err != nil {
+		return Date{}, err
+	}
+	if !date.Before(cutover) {
+		return Date{}, ErrSkippedDate
+	}
+	return date, nil
+}
+
+// MustNewDate is like NewDate but panics if the date cannot be created.
+func (c HybridCalendar) MustNewDate(year, month, day int) Date {
+	date, err := c.NewDate(year, month, day)
+	if err != nil {
+		panic(`timex: HybridCalendar.NewDate: ` + err.Error())
+	}
+	return date
+}
+
+// Parse parses a formatted string and returns the date it represents in the hybrid calendar.
+// The layout uses the tokens of ParseDate.
+func (c HybridCalendar) Parse(layout, value string) (Date, error) {
+	f, err := parseDate(layout, value, elemYear|elemMonth|elemDay, gregorianMonthNames)
+	if err != nil {
+		return Date{}, err
+	}
+	date, err := c.NewDate(f.year, f.month, f.day)
+	if err == ErrSkippedDate {
+		return Date{}, &ParseError{Layout: layout, Value: value, Kind: KindSkippedDate, Err: err}
+	}
+	return date, f.parseError(err)
+}
+
+// Format returns a textual representation of the date d in the hybrid calendar.
+// The layout uses the tokens of Date.Format.
+func (c HybridCalendar) Format(d Date, layout string) string {
+	var f dateFields
+	f.year, f.month, f.day = c.Date(d)
+	bytes := make([]byte, 0, len(layout)+10)
+	bytes = appendDate(bytes, layout, elemYear|elemMonth|elemDay, gregorianMonthNames, f)
+	return string(bytes)
+}
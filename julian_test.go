@@ -0,0 +1,148 @@
+package timex_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/invzhi/timex"
+)
+
+func TestDateFromJulianCalendar(t *testing.T) {
+	tests := []struct {
+		year, month, day int
+		date             timex.Date
+	}{
+		{1, 1, 1, timex.MustNewDate(0, 12, 30)},
+		{0, 2, 29, timex.MustNewDate(0, 2, 27)},
+		{-101, 3, 1, timex.MustNewDate(-101, 2, 26)},
+		{200, 3, 1, timex.MustNewDate(200, 3, 1)},
+		{1582, 10, 4, timex.MustNewDate(1582, 10, 14)},
+		{1582, 10, 5, timex.MustNewDate(1582, 10, 15)},
+		{1752, 9, 2, timex.MustNewDate(1752, 9, 13)},
+		{1900, 2, 29, timex.MustNewDate(1900, 3, 13)},
+		{2024, 1, 1, timex.MustNewDate(2024, 1, 14)},
+		{2100, 2, 29, timex.MustNewDate(2100, 3, 14)},
+	}
+
+	for _, tt := range tests {
+		date := timex.MustDateFromJulianCalendar(tt.year, tt.month, tt.day)
+		assert.Equal(t, tt.date, date)
+
+		year, month, day := tt.date.JulianCalendar()
+		assert.Equal(t, tt.year, year)
+		assert.Equal(t, tt.month, month)
+		assert.Equal(t, tt.day, day)
+	}
+
+	t.Run("Continuous", func(t *testing.T) {
+		date := timex.MustNewDate(-400, 1, 1)
+		year, month, day := date.JulianCalendar()
+		for date.Before(timex.MustNewDate(3000, 1, 1)) {
+			assert.Equal(t, date, timex.MustDateFromJulianCalendar(year, month, day))
+
+			date = date.AddDays(1)
+			y, m, d := date.JulianCalendar()
+			if m != month {
+				assert.Equal(t, 1, d)
+				if m == 1 {
+					assert.Equal(t, year+1, y)
+				}
+			} else {
+				assert.Equal(t, day+1, d)
+			}
+			year, month, day = y, m, d
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		tests := []struct {
+			year, month, day int
+			err              string
+		}{
+			{2024, 0, 1, "month is out of range [1,12]"},
+			{2024, 13, 1, "month is out of range [1,12]"},
+			{2023, 2, 29, "day is out of range [1,28]"},
+			{1900, 2, 30, "day is out of range [1,29]"},
+			{2024, 4, 31, "day is out of range [1,30]"},
+		}
+
+		for _, tt := range tests {
+			_, err := timex.DateFromJulianCalendar(tt.year, tt.month, tt.day)
+			assert.EqualError(t, err, tt.err)
+		}
+
+		assert.PanicsWithValue(t, "timex: DateFromJulianCalendar: day is out of range [1,28]", func() {
+			timex.MustDateFromJulianCalendar(2023, 2, 29)
+		})
+	})
+}
+
+func TestHybridCalendar(t *testing.T) {
+	tests := []struct {
+		calendar         timex.HybridCalendar
+		year, month, day int
+		date             timex.Date
+		gregorian        bool
+	}{
+		{timex.HybridCalendar{}, 1582, 10, 4, timex.MustNewDate(1582, 10, 14), false},
+		{timex.HybridCalendar{}, 1582, 10, 15, timex.MustNewDate(1582, 10, 15), true},
+		{timex.GregorianCutover, 1500, 2, 29, timex.MustNewDate(1500, 3, 10), false},
+		{timex.GregorianCutover, 1700, 2, 28, timex.MustNewDate(1700, 2, 28), true},
+		{timex.BritishCutover, 1700, 2, 29, timex.MustNewDate(1700, 3, 11), false},
+		{timex.BritishCutover, 1752, 9, 2, timex.MustNewDate(1752, 9, 13), false},
+		{timex.BritishCutover, 1752, 9, 14, timex.MustNewDate(1752, 9, 14), true},
+		{timex.BritishCutover, 2024, 2, 29, timex.MustNewDate(2024, 2, 29), true},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.date, tt.calendar.MustNewDate(tt.year, tt.month, tt.day))
+		assert.Equal(t, tt.gregorian, tt.calendar.IsGregorian(tt.date))
+
+		year, month, day := tt.calendar.Date(tt.date)
+		assert.Equal(t, tt.year, year)
+		assert.Equal(t, tt.month, month)
+		assert.Equal(t, tt.day, day)
+	}
+
+	t.Run("Format", func(t *testing.T) {
+		date := timex.MustNewDate(1732, 2, 22) // George Washington was born on February 11, 1731 in Julian calendar with the year starting on March 25.
+		assert.Equal(t, "1732-02-11", timex.BritishCutover.Format(date, "YYYY-MM-DD"))
+		assert.Equal(t, "1732-02-22", timex.GregorianCutover.Format(date, "YYYY-MM-DD"))
+
+		d, err := timex.BritishCutover.Parse("D MMMM YYYY", "11 February 1732")
+		assert.NoError(t, err)
+		assert.Equal(t, date, d)
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		tests := []struct {
+			calendar         timex.HybridCalendar
+			year, month, day int
+			err              string
+		}{
+			{timex.GregorianCutover, 1582, 10, 5, "date is skipped by the cutover"},
+			{timex.GregorianCutover, 1582, 10, 14, "date is skipped by the cutover"},
+			{timex.BritishCutover, 1752, 9, 3, "date is skipped by the cutover"},
+			{timex.BritishCutover, 1752, 9, 13, "date is skipped by the cutover"},
+			{timex.BritishCutover, 1800, 2, 29, "day is out of range [1,28]"},
+			{timex.BritishCutover, 1700, 2, 30, "day is out of range [1,29]"},
+		}
+
+		for _, tt := range tests {
+			_, err := tt.calendar.NewDate(tt.year, tt.month, tt.day)
+			assert.EqualError(t, err, tt.err)
+		}
+
+		_, err := timex.GregorianCutover.NewDate(1582, 10, 10)
+		assert.ErrorIs(t, err, timex.ErrSkippedDate)
+
+		_, err = timex.BritishCutover.Parse("YYYY-MM-DD", "1752-09-10")
+		assert.EqualError(t, err, `parsing "1752-09-10" as "YYYY-MM-DD": date is skipped by the cutover`)
+		assert.ErrorIs(t, err, timex.ErrSkippedDate)
+		var e *timex.ParseError
+		if assert.ErrorAs(t, err, &e) {
+			assert.Equal(t, timex.KindSkippedDate, e.Kind)
+		}
+	})
+}
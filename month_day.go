@@ -0,0 +1,122 @@
+package timex
+
+// MonthDay represents a specific day of a month without year in Gregorian calendar, such as a birthday.
+//
+// The zero value of type MonthDay is January 1.
+type MonthDay struct {
+	ordinal int // ordinal represents days since January 1 in a leap year.
+}
+
+// LeapDayPolicy describes how February 29 is mapped into a common year.
+type LeapDayPolicy int
+
+const (
+	// LeapDayToFeb28 maps February 29 to February 28 in a common year.
+	LeapDayToFeb28 LeapDayPolicy = iota
+	// LeapDayToMar1 maps February 29 to March 1 in a common year.
+	LeapDayToMar1
+)
+
+// leapYear is a leap year used to represent the month and day without year.
+const leapYear = 2000
+
+// NewMonthDay returns the month day corresponding to month and day.
+// February 29 is valid.
+func NewMonthDay(month, day int) (MonthDay, error) {
+	if month < 1 || month > 12 {
+		return MonthDay{}, rangeError(KindMonthOutOfRange, month, 1, 12)
+	}
+
+	if days := daysInMonth(leapYear, month); day < 1 || day > days {
+		return MonthDay{}, rangeError(KindDayOutOfRange, day, 1, days)
+	}
+
+	return MonthDay{ordinal: daysBeforeMonth(leapYear, month) + day - 1}, nil
+}
+
+// MustNewMonthDay is like NewMonthDay but panics if the month day cannot be created.
+func MustNewMonthDay(month, day int) MonthDay {
+	md, err := NewMonthDay(month, day)
+	if err != nil {
+		panic(`timex: NewMonthDay: ` + err.Error())
+	}
+	return md
+}
+
+// MonthDayOf returns the month day of the date d.
+func MonthDayOf(d Date) MonthDay {
+	_, month, day := ordinalToCalendar(d.ordinal)
+	return MonthDay{ordinal: daysBeforeMonth(leapYear, month) + day - 1}
+}
+
+// MonthDay returns the month and day specified by md.
+func (md MonthDay) MonthDay() (month, day int) {
+	_, month, day = ordinalDateToCalendar(leapYear, md.ordinal+1)
+	return month, day
+}
+
+// Month returns the month specified by md.
+func (md MonthDay) Month() int {
+	month, _ := md.MonthDay()
+	return month
+}
+
+// Day returns the day of month specified by md.
+func (md MonthDay) Day() int {
+	_, day := md.MonthDay()
+	return day
+}
+
+// IsLeapDay reports whether md is February 29.
+func (md MonthDay) IsLeapDay() bool {
+	return md.ordinal == 31+28
+}
+
+// InYear returns the date of md in the year.
+// If md is February 29 and the year is a common year, it is mapped by policy.
+func (md MonthDay) InYear(year int, policy LeapDayPolicy) Date {
+	if md.IsLeapDay() && !isLeap(year) {
+		n := calendarToOrdinal(year, 2, 28)
+		if policy == LeapDayToMar1 {
+			n++
+		}
+		return Date{ordinal: n}
+	}
+
+	month, day := md.MonthDay()
+	return Date{ordinal: calendarToOrdinal(year, month, day)}
+}
+
+// NextOccurrence returns the first date after from which is md.
+// February 29 only occurs in leap years.
+func (md MonthDay) NextOccurrence(from Date) Date {
+	month, day := md.MonthDay()
+	for year := from.Year(); ; year++ {
+		if day > daysInMonth(year, month) {
+			continue
+		}
+		if n := calendarToOrdinal(year, month, day); n > from.ordinal {
+			return Date{ordinal: n}
+		}
+	}
+}
+
+// IsZero reports whether the month day md is the zero value, January 1.
+func (md MonthDay) IsZero() bool {
+	return md.ordinal == 0
+}
+
+// Before reports whether the month day md is before mmd in a year.
+func (md MonthDay) Before(mmd MonthDay) bool {
+	return md.ordinal < mmd.ordinal
+}
+
+// After reports whether the month day md is after mmd in a year.
+func (md MonthDay) After(mmd MonthDay) bool {
+	return md.ordinal > mmd.ordinal
+}
+
+// Equal reports whether the month day md and mmd is the same day.
+func (md MonthDay) Equal(mmd MonthDay) bool {
+	return md.ordinal == mmd.ordinal
+}
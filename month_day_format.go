@@ -0,0 +1,139 @@
+package timex
+
+import "errors"
+
+const (
+	ISO8601MonthDay = "--MM-DD"
+)
+
+func parseStrictISO8601MonthDay(b []byte) (MonthDay, error) {
+	if len(b) != len(ISO8601MonthDay) {
+		return MonthDay{}, &ParseError{Layout: ISO8601MonthDay, Value: string(b)}
+	}
+
+	ok := true
+	parseUint := func(s []byte) (n int) {
+		for _, c := range s {
+			if !isDigit(c) {
+				ok = false
+				return 0
+			}
+			n = n*10 + int(c-'0')
+		}
+		return n
+	}
+
+	month := parseUint(b[2:4])
+	day := parseUint(b[5:7])
+	if !ok || b[0] != '-' || b[1] != '-' || b[4] != '-' {
+		return MonthDay{}, &ParseError{Layout: ISO8601MonthDay, Value: string(b)}
+	}
+
+	return NewMonthDay(month, day)
+}
+
+// ParseMonthDay parses a formatted string and returns the month day it represents.
+// The layout uses the month and day tokens of ParseDate, year tokens are parsed as literal.
+//
+//	M      1-12             Month, beginning at 1
+//	MM    01-12             Month, 2-digits
+//	MMM   Jan-Dec           The abbreviated month name
+//	MMMM  January-December  The full month name
+//	D      1-31             Day of month
+//	DD    01-31             Day of month, 2-digits
+func ParseMonthDay(layout, value string) (MonthDay, error) {
+	f, err := parseDate(layout, value, elemMonth|elemDay, gregorianMonthNames)
+	if err != nil {
+		return MonthDay{}, err
+	}
+	monthDay, err := NewMonthDay(f.month, f.day)
+	return monthDay, f.parseError(err)
+}
+
+func (md MonthDay) appendISO8601(b []byte) []byte {
+	month, day := md.MonthDay()
+
+	b = append(b, "--"...)
+	b = appendInt(b, month, 2)
+	b = append(b, '-')
+	b = appendInt(b, day, 2)
+	return b
+}
+
+// Format returns a textual representation of the month day.
+// The layout uses the month and day tokens of Date.Format, year tokens are formatted as literal.
+//
+//	M      1-12             Month, beginning at 1
+//	MM    01-12             Month, 2-digits
+//	MMM   Jan-Dec           The abbreviated month name
+//	MMMM  January-December  The full month name
+//	D      1-31             Day of month
+//	DD    01-31             Day of month, 2-digits
+func (md MonthDay) Format(layout string) string {
+	var f dateFields
+	f.month, f.day = md.MonthDay()
+	bytes := make([]byte, 0, len(layout)+10)
+	bytes = appendDate(bytes, layout, elemMonth|elemDay, gregorianMonthNames, f)
+	return string(bytes)
+}
+
+// String returns the textual representation of the month day.
+func (md MonthDay) String() string {
+	b := make([]byte, 0, len(ISO8601MonthDay))
+	b = md.appendISO8601(b)
+	return string(b)
+}
+
+// GoString returns the Go syntax of the month day.
+func (md MonthDay) GoString() string {
+	month, day := md.MonthDay()
+
+	bytes := make([]byte, 0, 32)
+
+	bytes = append(bytes, "timex.MustNewMonthDay("...)
+	bytes = appendInt(bytes, month, 0)
+
+	bytes = append(bytes, ", "...)
+	bytes = appendInt(bytes, day, 0)
+
+	bytes = append(bytes, ')')
+
+	return string(bytes)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The month day is in ISO 8601 format.
+func (md MonthDay) MarshalText() ([]byte, error) {
+	b := make([]byte, 0, len(ISO8601MonthDay))
+	return md.appendISO8601(b), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// The month day is expected to be in ISO 8601 format.
+func (md *MonthDay) UnmarshalText(data []byte) error {
+	var err error
+	*md, err = parseStrictISO8601MonthDay(data)
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The month day is a quoted string in ISO 8601 format.
+func (md MonthDay) MarshalJSON() ([]byte, error) {
+	b := make([]byte, 0, len(ISO8601MonthDay)+2)
+	b = append(b, '"')
+	b = md.appendISO8601(b)
+	b = append(b, '"')
+	return b, nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The month day is expected to be a quoted string in ISO 8601 format.
+func (md *MonthDay) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return errors.New("MonthDay.UnmarshalJSON: input is not a JSON string")
+	}
+	return md.UnmarshalText(data[1 : len(data)-1])
+}
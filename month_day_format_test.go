@@ -0,0 +1,146 @@
+package timex_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/invzhi/timex"
+)
+
+func TestParseMonthDay(t *testing.T) {
+	tests := []struct {
+		layout string
+		value  string
+	}{
+		{timex.ISO8601MonthDay, "--02-29"},
+		{"MM-DD", "02-29"},
+		{"M/D", "2/29"},
+		{"MMM D", "Feb 29"},
+		{"MMMM D", "February 29"},
+		{"YYYY-MM-DD", "YYYY-02-29"},
+		// Chinese
+		{"M月D日", "2月29日"},
+	}
+
+	for _, tt := range tests {
+		md, err := timex.ParseMonthDay(tt.layout, tt.value)
+		assert.NoError(t, err)
+		assert.Equal(t, timex.MustNewMonthDay(2, 29), md)
+		assert.Equal(t, tt.value, md.Format(tt.layout))
+	}
+}
+
+func TestParseMonthDayErrors(t *testing.T) {
+	tests := []struct {
+		layout    string
+		value     string
+		errString string
+	}{
+		{timex.ISO8601MonthDay, "02-29", `parsing "02-29" as "--MM-DD": cannot parse "02-29" as "--"`},
+		{timex.ISO8601MonthDay, "--2-29", `parsing "--2-29" as "--MM-DD": cannot parse "2-29" as "MM"`},
+		{timex.ISO8601MonthDay, "--02-30", `parsing "--02-30" as "--MM-DD": day is out of range [1,29]`},
+		{timex.ISO8601MonthDay, "--13-01", `parsing "--13-01" as "--MM-DD": month is out of range [1,12]`},
+	}
+
+	for _, tt := range tests {
+		_, err := timex.ParseMonthDay(tt.layout, tt.value)
+		assert.EqualError(t, err, tt.errString)
+	}
+}
+
+func FuzzParseMonthDay(f *testing.F) {
+	f.Add("--MM-DD", "--02-29")
+	f.Add(" --MM-DD", "")
+	f.Fuzz(func(t *testing.T, layout, value string) {
+		assert.NotPanics(t, func() {
+			_, _ = timex.ParseMonthDay(layout, value)
+		})
+	})
+}
+
+func TestMonthDayString(t *testing.T) {
+	tests := []struct {
+		month, day int
+		str, goStr string
+	}{
+		{1, 1, "--01-01", "timex.MustNewMonthDay(1, 1)"},
+		{2, 29, "--02-29", "timex.MustNewMonthDay(2, 29)"},
+		{12, 31, "--12-31", "timex.MustNewMonthDay(12, 31)"},
+	}
+
+	for _, tt := range tests {
+		md := timex.MustNewMonthDay(tt.month, tt.day)
+		assert.Equal(t, tt.str, md.String())
+		assert.Equal(t, tt.goStr, md.GoString())
+	}
+}
+
+func TestMonthDayMarshalJSON(t *testing.T) {
+	tests := []struct {
+		month, day int
+		s          string
+	}{
+		{1, 1, `"--01-01"`},
+		{2, 29, `"--02-29"`},
+		{12, 31, `"--12-31"`},
+	}
+
+	for _, tt := range tests {
+		md1 := timex.MustNewMonthDay(tt.month, tt.day)
+		bytes, err := md1.MarshalJSON()
+		assert.NoError(t, err)
+		assert.Equal(t, tt.s, string(bytes))
+
+		var md2 timex.MonthDay
+		err = md2.UnmarshalJSON(bytes)
+		assert.NoError(t, err)
+		assert.Equal(t, md1, md2)
+
+		text, err := md1.MarshalText()
+		assert.NoError(t, err)
+		assert.Equal(t, tt.s[1:len(tt.s)-1], string(text))
+
+		var md3 timex.MonthDay
+		err = md3.UnmarshalText(text)
+		assert.NoError(t, err)
+		assert.Equal(t, md1, md3)
+	}
+
+	t.Run("Null", func(t *testing.T) {
+		var md timex.MonthDay
+		err := md.UnmarshalJSON([]byte("null"))
+		assert.NoError(t, err)
+		assert.True(t, md.IsZero())
+	})
+}
+
+func TestMonthDayUnmarshalJSONError(t *testing.T) {
+	tests := []struct {
+		s         string
+		errString string
+	}{
+		{`--02-29`, `MonthDay.UnmarshalJSON: input is not a JSON string`},
+		{`""`, `parsing "" as "--MM-DD"`},
+		{`"02-29"`, `parsing "02-29" as "--MM-DD"`},
+		{`"--2-29"`, `parsing "--2-29" as "--MM-DD"`},
+		{`"++02-29"`, `parsing "++02-29" as "--MM-DD"`},
+		{`"--02/29"`, `parsing "--02/29" as "--MM-DD"`},
+		{`"--02-30"`, `day is out of range [1,29]`},
+	}
+
+	for _, tt := range tests {
+		var md timex.MonthDay
+		err := md.UnmarshalJSON([]byte(tt.s))
+		assert.EqualError(t, err, tt.errString)
+	}
+}
+
+func FuzzMonthDayUnmarshalJSON(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte) {
+		assert.NotPanics(t, func() {
+			var md timex.MonthDay
+			_ = md.UnmarshalJSON(data)
+		})
+	})
+}
@@ -0,0 +1,122 @@
+package timex_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/invzhi/timex"
+)
+
+func TestNewMonthDay(t *testing.T) {
+	tests := []struct {
+		month, day int
+		isLeapDay  bool
+	}{
+		{1, 1, false},
+		{2, 28, false},
+		{2, 29, true},
+		{3, 1, false},
+		{12, 31, false},
+	}
+
+	for _, tt := range tests {
+		md := timex.MustNewMonthDay(tt.month, tt.day)
+
+		month, day := md.MonthDay()
+		assert.Equal(t, tt.month, month)
+		assert.Equal(t, tt.day, day)
+
+		assert.Equal(t, tt.month, md.Month())
+		assert.Equal(t, tt.day, md.Day())
+		assert.Equal(t, tt.isLeapDay, md.IsLeapDay())
+
+		assert.Equal(t, md, timex.MonthDayOf(timex.MustNewDate(2024, tt.month, tt.day)))
+	}
+
+	assert.True(t, timex.MonthDay{}.IsZero())
+	assert.Equal(t, timex.MustNewMonthDay(1, 1), timex.MonthDay{})
+	assert.Equal(t, timex.MustNewMonthDay(3, 1), timex.MonthDayOf(timex.MustNewDate(2023, 3, 1)))
+
+	t.Run("Errors", func(t *testing.T) {
+		errTests := []struct {
+			month, day int
+			errString  string
+		}{
+			{0, 1, "month is out of range [1,12]"},
+			{13, 1, "month is out of range [1,12]"},
+			{2, 0, "day is out of range [1,29]"},
+			{2, 30, "day is out of range [1,29]"},
+			{4, 31, "day is out of range [1,30]"},
+		}
+
+		for _, tt := range errTests {
+			_, err := timex.NewMonthDay(tt.month, tt.day)
+			assert.EqualError(t, err, tt.errString)
+
+			assert.Panicsf(t, func() {
+				_ = timex.MustNewMonthDay(tt.month, tt.day)
+			}, "timex: NewMonthDay: "+tt.errString)
+		}
+	})
+}
+
+func TestMonthDayInYear(t *testing.T) {
+	tests := []struct {
+		md        timex.MonthDay
+		year      int
+		feb28Date timex.Date
+		mar1Date  timex.Date
+	}{
+		{timex.MustNewMonthDay(2, 29), 2024, timex.MustNewDate(2024, 2, 29), timex.MustNewDate(2024, 2, 29)},
+		{timex.MustNewMonthDay(2, 29), 2023, timex.MustNewDate(2023, 2, 28), timex.MustNewDate(2023, 3, 1)},
+		{timex.MustNewMonthDay(2, 29), 1900, timex.MustNewDate(1900, 2, 28), timex.MustNewDate(1900, 3, 1)},
+		{timex.MustNewMonthDay(2, 28), 2023, timex.MustNewDate(2023, 2, 28), timex.MustNewDate(2023, 2, 28)},
+		{timex.MustNewMonthDay(3, 1), 2023, timex.MustNewDate(2023, 3, 1), timex.MustNewDate(2023, 3, 1)},
+		{timex.MustNewMonthDay(12, 31), -1, timex.MustNewDate(-1, 12, 31), timex.MustNewDate(-1, 12, 31)},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.feb28Date, tt.md.InYear(tt.year, timex.LeapDayToFeb28))
+		assert.Equal(t, tt.mar1Date, tt.md.InYear(tt.year, timex.LeapDayToMar1))
+	}
+}
+
+func TestMonthDayNextOccurrence(t *testing.T) {
+	tests := []struct {
+		md   timex.MonthDay
+		from timex.Date
+		next timex.Date
+	}{
+		{timex.MustNewMonthDay(5, 20), timex.MustNewDate(2024, 1, 1), timex.MustNewDate(2024, 5, 20)},
+		{timex.MustNewMonthDay(5, 20), timex.MustNewDate(2024, 5, 19), timex.MustNewDate(2024, 5, 20)},
+		{timex.MustNewMonthDay(5, 20), timex.MustNewDate(2024, 5, 20), timex.MustNewDate(2025, 5, 20)},
+		{timex.MustNewMonthDay(1, 1), timex.MustNewDate(2024, 12, 31), timex.MustNewDate(2025, 1, 1)},
+		{timex.MustNewMonthDay(2, 29), timex.MustNewDate(2024, 2, 28), timex.MustNewDate(2024, 2, 29)},
+		{timex.MustNewMonthDay(2, 29), timex.MustNewDate(2024, 2, 29), timex.MustNewDate(2028, 2, 29)},
+		{timex.MustNewMonthDay(2, 29), timex.MustNewDate(2097, 1, 1), timex.MustNewDate(2104, 2, 29)},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.next, tt.md.NextOccurrence(tt.from))
+	}
+}
+
+func TestMonthDayBeforeAfter(t *testing.T) {
+	tests := []struct {
+		md1, md2      timex.MonthDay
+		before, after bool
+	}{
+		{timex.MustNewMonthDay(1, 1), timex.MustNewMonthDay(1, 1), false, false},
+		{timex.MustNewMonthDay(1, 1), timex.MustNewMonthDay(1, 2), true, false},
+		{timex.MustNewMonthDay(2, 28), timex.MustNewMonthDay(2, 29), true, false},
+		{timex.MustNewMonthDay(3, 1), timex.MustNewMonthDay(2, 29), false, true},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.before, tt.md1.Before(tt.md2))
+		assert.Equal(t, tt.after, tt.md1.After(tt.md2))
+		assert.Equal(t, !tt.before && !tt.after, tt.md1.Equal(tt.md2))
+		assert.Equal(t, !tt.before && !tt.after, tt.md2.Equal(tt.md1))
+	}
+}
@@ -0,0 +1,115 @@
+package timex
+
+import "strings"
+
+// ParseMode is the strictness of parsing.
+type ParseMode int
+
+const (
+	// ParseDefault is the parsing of ParseDate and ParseTimeOfDay, the value after the layout is ignored.
+	ParseDefault ParseMode = iota
+	// ParseStrict rejects the value after the layout, and the numbers without leading zeros in the layout,
+	// such as 03 of the month M, so a value is accepted only if it is formatted in the layout.
+	ParseStrict
+	// ParseLenient ignores the leading and trailing spaces, accepts any non-empty separators for the separators
+	// in the layout, such as 2024/3/5 of YYYY-MM-DD, and accepts the numbers without leading zeros.
+	// A time of day also accepts 24:00:00 and leap seconds like ParseTimeOfDayLenient.
+	ParseLenient
+)
+
+// ParseOptions is the options of parsing dates and times of day.
+// The zero value of ParseOptions parses like ParseDate and ParseTimeOfDay.
+//
+// The month names and am/pm are always matched case-insensitively.
+type ParseOptions struct {
+	// Mode is the strictness of parsing.
+	Mode ParseMode
+	// TwoDigitYearStart is the first year of the 100 years in which the two-digit years YY are,
+	// such as 1950 makes 50 to be 1950 and 49 to be 2049, see TwoDigitYearWindow for a sliding window.
+	// If it is zero, the two-digit years are in [1969,2068].
+	TwoDigitYearStart int
+}
+
+// TwoDigitYearWindow returns the first year of the 100 years in which the two-digit years are at most
+// future years after the year of ref, which slides with ref, such as 20 years after and 79 years before today.
+func TwoDigitYearWindow(ref Date, future int) int {
+	return ref.Year() + future - 99
+}
+
+// ParseDate parses a formatted string with the options and returns the date it represents.
+// The layout uses the tokens of ParseDate.
+func (o ParseOptions) ParseDate(layout, value string) (Date, error) {
+	if o.Mode == ParseLenient {
+		value = strings.TrimSpace(value)
+	}
+
+	f, rest, literal, err := parseDateFields(layout, value, elemYear|elemMonth|elemDay|elemWeek, gregorianMonthNames, o)
+	if err != nil {
+		return Date{}, err
+	}
+	if o.Mode == ParseStrict {
+		if err := checkTrailing(layout, value, rest, literal); err != nil {
+			return Date{}, err
+		}
+	}
+	return f.date(isoWeekScheme)
+}
+
+// ParseTimeOfDay parses a formatted string with the options and returns the time of day it represents.
+// The layout uses the tokens of ParseTimeOfDay.
+func (o ParseOptions) ParseTimeOfDay(layout, value string) (TimeOfDay, error) {
+	if o.Mode == ParseLenient {
+		value = strings.TrimSpace(value)
+	}
+	return parseTimeOfDay(layout, value, false, o)
+}
+
+// twoDigitYear returns the year of the two-digit year yy.
+func (o ParseOptions) twoDigitYear(yy int) int {
+	start := o.TwoDigitYearStart
+	if start == 0 {
+		start = 1969
+	}
+	return start + floorMod(yy-start, 100)
+}
+
+// padded returns the minimum digits of a number padded to n digits, which is 1 in lenient mode.
+func (o ParseOptions) padded(n int) int {
+	if o.Mode == ParseLenient {
+		return 1
+	}
+	return n
+}
+
+// atoiUnpadded parses a number of 1 to max digits, the leading zeros are rejected in strict mode.
+func (o ParseOptions) atoiUnpadded(s string, max int) (int, string, bool) {
+	n, rest, ok := atoi(s, 1, max)
+	if ok && o.leadingZero(s, rest) {
+		return 0, s, false
+	}
+	return n, rest, ok
+}
+
+// leadingZero reports whether the number parsed from s before rest has a leading zero in strict mode.
+func (o ParseOptions) leadingZero(s, rest string) bool {
+	return o.Mode == ParseStrict && len(s)-len(rest) > 1 && s[0] == '0' && isDigit(s[1])
+}
+
+// cutLiteral cuts the literal at the beginning of value, and returns the value after it.
+// In lenient mode, the literal of separators matches any non-empty separators.
+func (o ParseOptions) cutLiteral(value, literal string) (string, bool) {
+	if o.Mode == ParseLenient && literal != "" && strings.IndexFunc(literal, func(r rune) bool {
+		return r >= 0x80 || !isSeparator(byte(r))
+	}) < 0 {
+		i := 0
+		for i < len(value) && isSeparator(value[i]) {
+			i++
+		}
+		return value[i:], i > 0
+	}
+
+	if !strings.HasPrefix(value, literal) {
+		return value, false
+	}
+	return value[len(literal):], true
+}
@@ -0,0 +1,109 @@
+package timex_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/invzhi/timex"
+)
+
+func TestParseOptions_ParseDate(t *testing.T) {
+	strict := timex.ParseOptions{Mode: timex.ParseStrict}
+	lenient := timex.ParseOptions{Mode: timex.ParseLenient}
+
+	tests := []struct {
+		options timex.ParseOptions
+		layout  string
+		value   string
+		date    timex.Date
+	}{
+		{timex.ParseOptions{}, "YYYY-MM-DD", "2024-03-05xyz", timex.MustNewDate(2024, 3, 5)},
+		{strict, "YYYY-MM-DD", "2024-03-05", timex.MustNewDate(2024, 3, 5)},
+		{strict, "M/D/YYYY", "3/5/2024", timex.MustNewDate(2024, 3, 5)},
+		{strict, "YYYY年M月D日", "2024年3月5日", timex.MustNewDate(2024, 3, 5)},
+		{lenient, "YYYY-MM-DD", " 2024/3/5 ", timex.MustNewDate(2024, 3, 5)},
+		{lenient, "DD MMM YYYY", "5-mar-2024", timex.MustNewDate(2024, 3, 5)},
+		{lenient, "MMMM D, YYYY", "MARCH 5 2024", timex.MustNewDate(2024, 3, 5)},
+		{lenient, "YYYY-MM-DD", "2024 - 03 - 05", timex.MustNewDate(2024, 3, 5)},
+		{timex.ParseOptions{}, "DD/MM/YY", "05/03/68", timex.MustNewDate(2068, 3, 5)},
+		{timex.ParseOptions{}, "DD/MM/YY", "05/03/69", timex.MustNewDate(1969, 3, 5)},
+		{timex.ParseOptions{TwoDigitYearStart: 1950}, "DD/MM/YY", "05/03/49", timex.MustNewDate(2049, 3, 5)},
+		{timex.ParseOptions{TwoDigitYearStart: 1950}, "DD/MM/YY", "05/03/50", timex.MustNewDate(1950, 3, 5)},
+		{timex.ParseOptions{TwoDigitYearStart: timex.TwoDigitYearWindow(timex.MustNewDate(2024, 3, 5), 20)}, "DD/MM/YY", "05/03/44", timex.MustNewDate(2044, 3, 5)},
+		{timex.ParseOptions{TwoDigitYearStart: timex.TwoDigitYearWindow(timex.MustNewDate(2024, 3, 5), 20)}, "DD/MM/YY", "05/03/45", timex.MustNewDate(1945, 3, 5)},
+	}
+
+	for _, tt := range tests {
+		date, err := tt.options.ParseDate(tt.layout, tt.value)
+		assert.NoError(t, err, tt.value)
+		assert.Equal(t, tt.date, date, tt.value)
+	}
+
+	t.Run("Errors", func(t *testing.T) {
+		tests := []struct {
+			options timex.ParseOptions
+			layout  string
+			value   string
+			err     string
+		}{
+			{strict, "YYYY-MM-DD", "2024-03-05xyz", `parsing "2024-03-05xyz" as "YYYY-MM-DD": extra text "xyz"`},
+			{strict, "YYYY年M月D日", "2024年3月5", `parsing "2024年3月5" as "YYYY年M月D日": cannot parse "" as "日"`},
+			{strict, "M/D/YYYY", "03/5/2024", `parsing "03/5/2024" as "M/D/YYYY": cannot parse "03/5/2024" as "M"`},
+			{strict, "YYYY-MM-DD", "2024-3-05", `parsing "2024-3-05" as "YYYY-MM-DD": cannot parse "3-05" as "MM"`},
+			{timex.ParseOptions{}, "YYYY-MM-DD", "2024/03/05", `parsing "2024/03/05" as "YYYY-MM-DD": cannot parse "/03/05" as "-"`},
+			{lenient, "YYYY-MM-DD", "202403-05", `parsing "202403-05" as "YYYY-MM-DD": cannot parse "03-05" as "-"`},
+			{lenient, "YYYY-MM-DD", "2024-02-30", `parsing "2024-02-30" as "YYYY-MM-DD": day is out of range [1,29]`},
+		}
+
+		for _, tt := range tests {
+			_, err := tt.options.ParseDate(tt.layout, tt.value)
+			assert.EqualError(t, err, tt.err, tt.value)
+		}
+
+		_, err := strict.ParseDate("YYYY-MM-DD", "2024-03-05xyz")
+		assert.ErrorIs(t, err, timex.ErrTrailingData)
+	})
+}
+
+func TestParseOptions_ParseTimeOfDay(t *testing.T) {
+	strict := timex.ParseOptions{Mode: timex.ParseStrict}
+	lenient := timex.ParseOptions{Mode: timex.ParseLenient}
+
+	tests := []struct {
+		options timex.ParseOptions
+		layout  string
+		value   string
+		t       timex.TimeOfDay
+	}{
+		{timex.ParseOptions{}, "HH:mm", "12:34:56", timex.MustNewTimeOfDay(12, 34, 0, 0)},
+		{strict, "HH:mm:ss", "12:34:56.5", timex.MustNewTimeOfDay(12, 34, 56, 5e8)},
+		{strict, "h:mm a", "9:05 pm", timex.MustNewTimeOfDay(21, 5, 0, 0)},
+		{lenient, "HH:mm:ss", " 9.5.7 ", timex.MustNewTimeOfDay(9, 5, 7, 0)},
+		{lenient, "h:mm A", "9:05  pm", timex.MustNewTimeOfDay(21, 5, 0, 0)},
+		{lenient, "HH:mm:ss", "24:00:00", timex.EndOfDay()},
+	}
+
+	for _, tt := range tests {
+		v, err := tt.options.ParseTimeOfDay(tt.layout, tt.value)
+		assert.NoError(t, err, tt.value)
+		assert.Equal(t, tt.t, v, tt.value)
+	}
+
+	tests2 := []struct {
+		options timex.ParseOptions
+		layout  string
+		value   string
+		err     string
+	}{
+		{strict, "HH:mm", "12:34:56", `parsing "12:34:56" as "HH:mm": extra text ":56"`},
+		{strict, "H:m:s", "09:05:07", `parsing "09:05:07" as "H:m:s": cannot parse "09:05:07" as "H"`},
+		{strict, "H:m:s", "9:5:07", `parsing "9:5:07" as "H:m:s": cannot parse "07" as "s"`},
+		{timex.ParseOptions{}, "HH:mm:ss", "24:00:00", `parsing "24:00:00" as "HH:mm:ss": hour is out of range [0,23]`},
+	}
+
+	for _, tt := range tests2 {
+		_, err := tt.options.ParseTimeOfDay(tt.layout, tt.value)
+		assert.EqualError(t, err, tt.err, tt.value)
+	}
+}
@@ -0,0 +1,324 @@
+package timex
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+var persianMonthLatinNames = []string{
+	"Farvardin",
+	"Ordibehesht",
+	"Khordad",
+	"Tir",
+	"Mordad",
+	"Shahrivar",
+	"Mehr",
+	"Aban",
+	"Azar",
+	"Dey",
+	"Bahman",
+	"Esfand",
+}
+
+var persianMonthPersianNames = []string{
+	"فروردین",
+	"اردیبهشت",
+	"خرداد",
+	"تیر",
+	"مرداد",
+	"شهریور",
+	"مهر",
+	"آبان",
+	"آذر",
+	"دی",
+	"بهمن",
+	"اسفند",
+}
+
+var persianMonthDariNames = []string{
+	"حمل",
+	"ثور",
+	"جوزا",
+	"سرطان",
+	"اسد",
+	"سنبله",
+	"میزان",
+	"عقرب",
+	"قوس",
+	"جدی",
+	"دلو",
+	"حوت",
+}
+
+// PersianMonthNames is the names of months in Persian calendar.
+type PersianMonthNames int
+
+const (
+	PersianMonthNamesLatin   PersianMonthNames = iota // Farvardin, Ordibehesht, ..., Esfand.
+	PersianMonthNamesPersian                          // The names used in Iran, فروردین, اردیبهشت, ..., اسفند.
+	PersianMonthNamesDari                             // The names used in Afghanistan, حمل, ثور, ..., حوت.
+)
+
+func (n PersianMonthNames) names() dateNames {
+	switch n {
+	case PersianMonthNamesPersian:
+		return dateNames{short: persianMonthPersianNames, long: persianMonthPersianNames}
+	case PersianMonthNamesDari:
+		return dateNames{short: persianMonthDariNames, long: persianMonthDariNames}
+	default:
+		return dateNames{short: persianMonthLatinNames, long: persianMonthLatinNames}
+	}
+}
+
+// persianBreaks is the years when the leap pattern of 33 years changes, computed by Kazimierz M. Borkowski.
+var persianBreaks = [...]int{-61, 9, 38, 199, 426, 686, 756, 818, 1111, 1181, 1210, 1635, 2060, 2097, 2192, 2262, 2324, 2394, 2456, 3178}
+
+// persianYear returns the years after the last leap year, 0 means the year is a leap year,
+// and the day in March of Gregorian calendar when the year starts.
+// The year is expected in [-61,3177].
+func persianYear(year int) (leap, march int) {
+	gy := year + 621
+	leapJ := -14
+	jp := persianBreaks[0]
+
+	var jump int
+	for _, jm := range persianBreaks[1:] {
+		jump = jm - jp
+		if year < jm {
+			break
+		}
+		leapJ += jump/33*8 + jump%33/4
+		jp = jm
+	}
+
+	n := year - jp
+	leapJ += n/33*8 + (n%33+3)/4
+	if jump%33 == 4 && jump-n == 4 {
+		leapJ++
+	}
+
+	leapG := gy/4 - (gy/100+1)*3/4 - 150
+	march = 20 + leapJ - leapG
+
+	if jump-n < 6 {
+		n = n - jump + (jump+4)/33*33
+	}
+	leap = ((n+1)%33 - 1) % 4
+	if leap == -1 {
+		leap = 4
+	}
+	return leap, march
+}
+
+const (
+	persianMinYear = -61
+	persianMaxYear = 3177
+	// persianEpoch is the ordinal of Farvardin 1 of year 1 in the 33-year arithmetic rule, which is March 21, 622.
+	persianEpoch           = 226894
+	daysEvery33PersianYear = 33*365 + 8
+)
+
+// PersianCalendar is the Solar Hijri calendar, the first 6 months have 31 days, the next 5 months have 30 days,
+// and the last month has 29 days, or 30 days in a leap year.
+//
+// By default the leap years are computed by the algorithm of Kazimierz M. Borkowski, which matches the astronomical
+// calendar in year -61 to 3177, years out of the range fall back to the 33-year arithmetic rule,
+// which continues from the new years before and after the range.
+type PersianCalendar struct {
+	// Arithmetic reports whether the leap years are computed by the 33-year arithmetic rule,
+	// year y is a leap year if (25y + 11) mod 33 < 8. It matches the astronomical calendar in year 1178 to 1633.
+	Arithmetic bool
+	// MonthNames is the names of months in formatting and parsing.
+	MonthNames PersianMonthNames
+	// PersianDigits reports whether the digits are formatted in Extended Arabic-Indic digits, such as ۱۴۰۳.
+	PersianDigits bool
+}
+
+func (c PersianCalendar) arithmetic(year int) bool {
+	return c.Arithmetic || year < persianMinYear || year > persianMaxYear
+}
+
+// IsLeapYear reports whether the year is a leap year, which has 366 days.
+func (c PersianCalendar) IsLeapYear(year int) bool {
+	if c.arithmetic(year) {
+		return floorMod(25*year+11, 33) < 8
+	}
+	leap, _ := persianYear(year)
+	return leap == 0
+}
+
+// MonthsInYear returns the number of months in the year, which is 12.
+func (c PersianCalendar) MonthsInYear(year int) int {
+	return 12
+}
+
+// MonthName returns the name of the month specified by MonthNames.
+func (c PersianCalendar) MonthName(year, month int) string {
+	return c.MonthNames.names().long[month-1]
+}
+
+// DaysInMonth returns the number of days in the month of the year.
+func (c PersianCalendar) DaysInMonth(year, month int) int {
+	switch {
+	case month <= 6:
+		return 31
+	case month <= 11 || c.IsLeapYear(year):
+		return 30
+	default:
+		return 29
+	}
+}
+
+// newYear returns the ordinal of Farvardin 1 of the year.
+func (c PersianCalendar) newYear(year int) int {
+	switch {
+	case c.Arithmetic:
+		return persianArithmeticNewYear(year)
+	case year < persianMinYear:
+		return c.newYear(persianMinYear) - (persianArithmeticNewYear(persianMinYear) - persianArithmeticNewYear(year))
+	case year > persianMaxYear:
+		n := c.newYear(persianMaxYear) + 365
+		if c.IsLeapYear(persianMaxYear) {
+			n++
+		}
+		return n + persianArithmeticNewYear(year) - persianArithmeticNewYear(persianMaxYear+1)
+	}
+
+	_, march := persianYear(year)
+	return calendarToOrdinal(year+621, 3, march)
+}
+
+// persianArithmeticNewYear returns the ordinal of Farvardin 1 of the year in the 33-year arithmetic rule.
+func persianArithmeticNewYear(year int) int {
+	n33, y := norm1(0, year, 33)
+	n := persianEpoch + n33*daysEvery33PersianYear + (y-1)*365
+	for i := 1; i < y; i++ {
+		if floorMod(25*i+11, 33) < 8 {
+			n++
+		}
+	}
+	return n
+}
+
+// ToOrdinal returns the ordinal of the year, month, and day in the calendar, see Calendar for the ordinal.
+func (c PersianCalendar) ToOrdinal(year, month, day int) int {
+	n := c.newYear(year) + (month-1)*31 + day - 1
+	if month > 7 {
+		n -= month - 7 // The months since Mehr have 30 days.
+	}
+	return n
+}
+
+// FromOrdinal returns the year, month, and day of the ordinal in the calendar, see Calendar for the ordinal.
+func (c PersianCalendar) FromOrdinal(n int) (year, month, day int) {
+	year, _ = ordinalToOrdinalDate(n)
+	year -= 621
+	for n < c.newYear(year) {
+		year--
+	}
+	for n >= c.newYear(year+1) {
+		year++
+	}
+
+	n -= c.newYear(year)
+	if n < 6*31 {
+		return year, n/31 + 1, n%31 + 1
+	}
+	n -= 6 * 31
+	return year, n/30 + 7, n%30 + 1
+}
+
+// NewDate returns the date corresponding to year, month, and day in the calendar.
+func (c PersianCalendar) NewDate(year, month, day int) (Date, error) {
+	if month < 1 || month > 12 {
+		return Date{}, rangeError(KindMonthOutOfRange, month, 1, 12)
+	}
+
+	if days := c.DaysInMonth(year, month); day < 1 || day > days {
+		return Date{}, rangeError(KindDayOutOfRange, day, 1, days)
+	}
+
+	return Date{ordinal: c.ToOrdinal(year, month, day)}, nil
+}
+
+// MustNewDate is like NewDate but panics if the date cannot be created.
+func (c PersianCalendar) MustNewDate(year, month, day int) Date {
+	date, err := c.NewDate(year, month, day)
+	if err != nil {
+		panic(`timex: PersianCalendar.NewDate: ` + err.Error())
+	}
+	return date
+}
+
+// Date returns the year, month, and day specified by d in the calendar.
+func (c PersianCalendar) Date(d Date) (year, month, day int) {
+	return c.FromOrdinal(d.ordinal)
+}
+
+// persianDigits replaces Extended Arabic-Indic and Arabic-Indic digits to ASCII digits.
+var persianDigits = strings.NewReplacer(
+	"۰", "0", "۱", "1", "۲", "2", "۳", "3", "۴", "4", "۵", "5", "۶", "6", "۷", "7", "۸", "8", "۹", "9",
+	"٠", "0", "١", "1", "٢", "2", "٣", "3", "٤", "4", "٥", "5", "٦", "6", "٧", "7", "٨", "8", "٩", "9",
+)
+
+// Parse parses a formatted string and returns the date it represents in the calendar.
+// The layout uses the tokens of ParseDate, and the month names are specified by MonthNames.
+// The digits in value can be ASCII, Extended Arabic-Indic or Arabic-Indic digits.
+func (c PersianCalendar) Parse(layout, value string) (Date, error) {
+	f, err := parseDate(layout, persianDigits.Replace(value), elemYear|elemMonth|elemDay, c.MonthNames.names())
+	if err != nil {
+		return Date{}, persianParseError(value, err)
+	}
+	date, err := c.NewDate(f.year, f.month, f.day)
+	return date, persianParseError(value, f.parseError(err))
+}
+
+// persianParseError maps the *ParseError err of the value with ASCII digits back to value,
+// other errors are returned unchanged.
+func persianParseError(value string, err error) error {
+	e, ok := err.(*ParseError)
+	if !ok {
+		return err
+	}
+	start := persianOffset(value, e.Offset)
+	end := persianOffset(value, e.Offset+len(e.ValueElem))
+	e.Value, e.ValueElem, e.Offset = value, value[start:end], start
+	return e
+}
+
+// persianOffset returns the byte offset in value of the byte offset n in the value with ASCII digits.
+func persianOffset(value string, n int) int {
+	i := 0
+	for n > 0 && i < len(value) {
+		r, size := utf8.DecodeRuneInString(value[i:])
+		i += size
+		if r >= '۰' && r <= '۹' || r >= '٠' && r <= '٩' {
+			n--
+		} else {
+			n -= size
+		}
+	}
+	return i
+}
+
+// Format returns a textual representation of the date d in the calendar.
+// The layout uses the tokens of Date.Format, and the month names are specified by MonthNames.
+// If PersianDigits is true, all the digits in result are Extended Arabic-Indic digits.
+func (c PersianCalendar) Format(d Date, layout string) string {
+	var f dateFields
+	f.year, f.month, f.day = c.Date(d)
+	bytes := make([]byte, 0, len(layout)+10)
+	bytes = appendDate(bytes, layout, elemYear|elemMonth|elemDay, c.MonthNames.names(), f)
+	if !c.PersianDigits {
+		return string(bytes)
+	}
+
+	var b strings.Builder
+	for _, r := range string(bytes) {
+		if r >= '0' && r <= '9' {
+			r += '۰' - '0'
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
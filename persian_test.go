@@ -0,0 +1,183 @@
+package timex_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/invzhi/timex"
+)
+
+func TestPersianCalendar(t *testing.T) {
+	tests := []struct {
+		calendar         timex.PersianCalendar
+		year, month, day int
+		date             timex.Date
+	}{
+		{timex.PersianCalendar{}, 1, 1, 1, timex.MustNewDate(622, 3, 22)},
+		{timex.PersianCalendar{}, 1178, 1, 1, timex.MustNewDate(1799, 3, 21)},
+		{timex.PersianCalendar{}, 1357, 11, 22, timex.MustNewDate(1979, 2, 11)},
+		{timex.PersianCalendar{}, 1378, 10, 11, timex.MustNewDate(2000, 1, 1)},
+		{timex.PersianCalendar{}, 1402, 12, 29, timex.MustNewDate(2024, 3, 19)},
+		{timex.PersianCalendar{}, 1403, 1, 1, timex.MustNewDate(2024, 3, 20)},
+		{timex.PersianCalendar{}, 1403, 6, 31, timex.MustNewDate(2024, 9, 21)},
+		{timex.PersianCalendar{}, 1403, 7, 1, timex.MustNewDate(2024, 9, 22)},
+		{timex.PersianCalendar{}, 1403, 12, 30, timex.MustNewDate(2025, 3, 20)},
+		{timex.PersianCalendar{}, 1404, 1, 1, timex.MustNewDate(2025, 3, 21)},
+		{timex.PersianCalendar{Arithmetic: true}, 1403, 12, 30, timex.MustNewDate(2025, 3, 20)},
+		{timex.PersianCalendar{Arithmetic: true}, 1, 1, 1, timex.MustNewDate(622, 3, 21)},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.date, tt.calendar.MustNewDate(tt.year, tt.month, tt.day))
+
+		year, month, day := tt.calendar.Date(tt.date)
+		assert.Equal(t, tt.year, year)
+		assert.Equal(t, tt.month, month)
+		assert.Equal(t, tt.day, day)
+	}
+
+	t.Run("LeapYear", func(t *testing.T) {
+		calendar := timex.PersianCalendar{}
+		arithmetic := timex.PersianCalendar{Arithmetic: true}
+
+		var years []int
+		for year := 1390; year <= 1420; year++ {
+			if calendar.IsLeapYear(year) {
+				years = append(years, year)
+			}
+		}
+		assert.Equal(t, []int{1391, 1395, 1399, 1403, 1408, 1412, 1416, 1420}, years)
+
+		for year := -61; year < 3177; year++ {
+			days := calendar.MustNewDate(year+1, 1, 1).Sub(calendar.MustNewDate(year, 1, 1))
+			assert.Equal(t, calendar.IsLeapYear(year), days == 366)
+
+			// The 33-year arithmetic rule matches the astronomical calendar in 1178 to 1633.
+			if year >= 1178 && year <= 1633 {
+				assert.Equal(t, calendar.IsLeapYear(year), arithmetic.IsLeapYear(year))
+				assert.Equal(t, calendar.MustNewDate(year, 1, 1), arithmetic.MustNewDate(year, 1, 1))
+			}
+		}
+	})
+
+	t.Run("Continuous", func(t *testing.T) {
+		for _, calendar := range []timex.PersianCalendar{{}, {Arithmetic: true}} {
+			date := timex.MustNewDate(1900, 1, 1)
+			for n := 0; n < 20000; n++ {
+				year, month, day := calendar.Date(date)
+				assert.Equal(t, date, calendar.MustNewDate(year, month, day))
+				date = date.AddDays(3)
+			}
+		}
+	})
+
+	t.Run("Boundary", func(t *testing.T) {
+		// The 33-year arithmetic rule continues from the range of the astronomical calendar.
+		calendar := timex.PersianCalendar{}
+		for _, year := range []int{-61, 3178} {
+			start := calendar.MustNewDate(year-3, 1, 1)
+			end := calendar.MustNewDate(year+3, 1, 1)
+			prevYear := year - 4
+			for date := start; date.Before(end); date = date.AddDays(1) {
+				y, m, d := calendar.Date(date)
+				assert.Equal(t, date, calendar.MustNewDate(y, m, d))
+
+				if m == 1 && d == 1 {
+					assert.Equal(t, prevYear+1, y)
+					days := calendar.MustNewDate(y, 1, 1).Sub(calendar.MustNewDate(y-1, 1, 1))
+					assert.Equal(t, calendar.IsLeapYear(y-1), days == 366, y-1)
+				}
+				prevYear = y
+			}
+		}
+
+		y, m, d := calendar.Date(calendar.MustNewDate(3177, 12, 29).AddDays(1))
+		assert.Equal(t, [3]int{3178, 1, 1}, [3]int{y, m, d})
+		y, m, d = calendar.Date(calendar.MustNewDate(-61, 1, 1).AddDays(-1))
+		assert.Equal(t, -62, y)
+		assert.Equal(t, calendar.DaysInMonth(-62, 12), d)
+		assert.Equal(t, 12, m)
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		tests := []struct {
+			year, month, day int
+			err              string
+		}{
+			{1403, 0, 1, "month is out of range [1,12]"},
+			{1403, 13, 1, "month is out of range [1,12]"},
+			{1403, 6, 32, "day is out of range [1,31]"},
+			{1403, 7, 31, "day is out of range [1,30]"},
+			{1404, 12, 30, "day is out of range [1,29]"},
+		}
+
+		for _, tt := range tests {
+			_, err := timex.PersianCalendar{}.NewDate(tt.year, tt.month, tt.day)
+			assert.EqualError(t, err, tt.err)
+		}
+
+		assert.PanicsWithValue(t, "timex: PersianCalendar.NewDate: day is out of range [1,29]", func() {
+			timex.PersianCalendar{}.MustNewDate(1404, 12, 30)
+		})
+	})
+}
+
+func TestPersianCalendarFormat(t *testing.T) {
+	tests := []struct {
+		calendar timex.PersianCalendar
+		layout   string
+		date     timex.Date
+		value    string
+	}{
+		{timex.PersianCalendar{}, "YYYY/MM/DD", timex.MustNewDate(2024, 3, 20), "1403/01/01"},
+		{timex.PersianCalendar{}, "D MMMM YYYY", timex.MustNewDate(2024, 3, 20), "1 Farvardin 1403"},
+		{timex.PersianCalendar{}, "D MMM YYYY", timex.MustNewDate(2025, 3, 20), "30 Esfand 1403"},
+		{timex.PersianCalendar{MonthNames: timex.PersianMonthNamesPersian}, "D MMMM YYYY", timex.MustNewDate(2024, 9, 22), "1 مهر 1403"},
+		{timex.PersianCalendar{MonthNames: timex.PersianMonthNamesDari}, "D MMMM YYYY", timex.MustNewDate(2024, 3, 20), "1 حمل 1403"},
+		{timex.PersianCalendar{PersianDigits: true}, "YYYY/MM/DD", timex.MustNewDate(2024, 3, 20), "۱۴۰۳/۰۱/۰۱"},
+		{timex.PersianCalendar{MonthNames: timex.PersianMonthNamesPersian, PersianDigits: true}, "D MMMM YYYY", timex.MustNewDate(2025, 3, 20), "۳۰ اسفند ۱۴۰۳"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.value, tt.calendar.Format(tt.date, tt.layout))
+
+		date, err := tt.calendar.Parse(tt.layout, tt.value)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.date, date)
+	}
+
+	date, err := timex.PersianCalendar{}.Parse("YYYY/MM/DD", "١٤٠٣/٠١/٠١")
+	assert.NoError(t, err)
+	assert.Equal(t, timex.MustNewDate(2024, 3, 20), date)
+
+	_, err = timex.PersianCalendar{}.Parse("YYYY/MM/DD", "1404/12/30")
+	assert.EqualError(t, err, `parsing "1404/12/30" as "YYYY/MM/DD": day is out of range [1,29]`)
+
+	t.Run("Errors", func(t *testing.T) {
+		tests := []struct {
+			value     string
+			kind      timex.ErrorKind
+			offset    int
+			valueElem string
+			err       string
+		}{
+			{"۱۴۰۴/۱۲/۳۰", timex.KindDayOutOfRange, 14, "۳۰", `parsing "۱۴۰۴/۱۲/۳۰" as "YYYY/MM/DD": day is out of range [1,29]`},
+			{"۱۴۰۴-۱۲/۰۱", timex.KindSyntax, 8, "-۱۲/۰۱", `parsing "۱۴۰۴-۱۲/۰۱" as "YYYY/MM/DD": cannot parse "-۱۲/۰۱" as "/"`},
+			{"۱۴۰۴/x۲/۰۱", timex.KindSyntax, 9, "x۲/۰۱", `parsing "۱۴۰۴/x۲/۰۱" as "YYYY/MM/DD": cannot parse "x۲/۰۱" as "MM"`},
+		}
+
+		for _, tt := range tests {
+			_, err := timex.PersianCalendar{}.Parse("YYYY/MM/DD", tt.value)
+			assert.EqualError(t, err, tt.err)
+
+			var e *timex.ParseError
+			if assert.ErrorAs(t, err, &e) {
+				assert.Equal(t, tt.kind, e.Kind, tt.value)
+				assert.Equal(t, tt.offset, e.Offset, tt.value)
+				assert.Equal(t, tt.valueElem, e.ValueElem, tt.value)
+				assert.Equal(t, tt.valueElem, tt.value[e.Offset:e.Offset+len(e.ValueElem)], tt.value)
+			}
+		}
+	})
+}
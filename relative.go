@@ -0,0 +1,400 @@
+package timex
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// RelativeUnit is a unit of relative dates, such as the week of "in 2 weeks".
+type RelativeUnit int
+
+// The units of relative dates.
+const (
+	RelativeDay RelativeUnit = iota + 1
+	RelativeWeek
+	RelativeMonth
+	RelativeYear
+)
+
+// RelativeGrammar is the words of a language in which relative dates are parsed.
+// The words are in lower case, and a word may be several words separated by spaces, such as "from now".
+//
+// The phrases of relative dates are the following, where the words in angle brackets are given by the grammar:
+//
+//	<day>                                   today, tomorrow
+//	<number> <unit> <future|past>           3 days ago, 2 weeks from now
+//	<future|past> <number> <unit>           in 2 weeks
+//	<next|last|this> <weekday|unit>         next monday, last month
+//	<ordinal> <weekday|day> <of> <period>   first tuesday of march, last day of next month
+//	<weekday>                               friday
+//
+// The period is a month name followed by an optional year, such as "march 2025",
+// or <next|last|this> followed by a week, month or year, such as "next month".
+type RelativeGrammar struct {
+	// Days is the days relative to the reference date, such as 1 of "tomorrow".
+	Days map[string]int
+	// Next, Last and This are the words before a weekday or a unit, such as "next" of "next monday".
+	Next, Last, This []string
+	// Future and Past are the words before or after a number of units, such as "in" of "in 2 weeks" and "ago" of "3 days ago".
+	Future, Past []string
+	// Of is the words between an ordinal and a period, such as "of" of "first tuesday of march".
+	Of []string
+	// Numbers is the numbers in words, such as 1 of "a week ago". The numbers in digits are always accepted.
+	Numbers map[string]int
+	// Ordinals is the ordinals in words, such as 1 of "first", the negative ordinals count from the end, such as -1 of "last".
+	Ordinals map[string]int
+	// Units is the names of units, such as "day" and "days".
+	Units map[string]RelativeUnit
+	// Weekdays is the names of weekdays, such as "monday" and "mon".
+	Weekdays map[string]time.Weekday
+	// Months is the names of months which are numbered from 1, such as "march" and "mar".
+	Months map[string]int
+	// WeekStart is the first day of a week, which is used by the phrases like "first day of next week".
+	WeekStart time.Weekday
+}
+
+// EnglishRelativeGrammar is the grammar of English relative dates.
+var EnglishRelativeGrammar = RelativeGrammar{
+	Days: map[string]int{
+		"today":                0,
+		"tomorrow":             1,
+		"yesterday":            -1,
+		"day after tomorrow":   2,
+		"day before yesterday": -2,
+	},
+	Next:   []string{"next"},
+	Last:   []string{"last", "previous"},
+	This:   []string{"this"},
+	Future: []string{"in", "later", "from now", "from today"},
+	Past:   []string{"ago", "before today"},
+	Of:     []string{"of", "in"},
+	Numbers: map[string]int{
+		"a": 1, "an": 1, "one": 1, "two": 2, "three": 3, "four": 4, "five": 5, "six": 6,
+		"seven": 7, "eight": 8, "nine": 9, "ten": 10, "eleven": 11, "twelve": 12,
+	},
+	Ordinals: map[string]int{
+		"first": 1, "second": 2, "third": 3, "fourth": 4, "fifth": 5,
+		"1st": 1, "2nd": 2, "3rd": 3, "4th": 4, "5th": 5,
+		"last": -1, "second last": -2, "second to last": -2,
+	},
+	Units: map[string]RelativeUnit{
+		"day": RelativeDay, "days": RelativeDay,
+		"week": RelativeWeek, "weeks": RelativeWeek,
+		"month": RelativeMonth, "months": RelativeMonth,
+		"year": RelativeYear, "years": RelativeYear,
+	},
+	Weekdays: map[string]time.Weekday{
+		"sunday": time.Sunday, "sun": time.Sunday,
+		"monday": time.Monday, "mon": time.Monday,
+		"tuesday": time.Tuesday, "tue": time.Tuesday, "tues": time.Tuesday,
+		"wednesday": time.Wednesday, "wed": time.Wednesday,
+		"thursday": time.Thursday, "thu": time.Thursday, "thur": time.Thursday, "thurs": time.Thursday,
+		"friday": time.Friday, "fri": time.Friday,
+		"saturday": time.Saturday, "sat": time.Saturday,
+	},
+	Months: map[string]int{
+		"january": 1, "jan": 1, "february": 2, "feb": 2, "march": 3, "mar": 3,
+		"april": 4, "apr": 4, "may": 5, "june": 6, "jun": 6, "july": 7, "jul": 7,
+		"august": 8, "aug": 8, "september": 9, "sep": 9, "sept": 9, "october": 10, "oct": 10,
+		"november": 11, "nov": 11, "december": 12, "dec": 12,
+	},
+	WeekStart: time.Monday,
+}
+
+// ParseRelativeDate parses an English phrase of a date relative to the reference date ref,
+// such as "tomorrow", "next friday", "in 3 weeks" and "last day of next month", see RelativeGrammar for the phrases.
+// The date in layout RFC3339Date is also accepted.
+func ParseRelativeDate(s string, ref Date) (Date, error) {
+	return EnglishRelativeGrammar.Parse(s, ref)
+}
+
+// Parse parses a phrase of a date relative to the reference date ref in the grammar.
+// The phrase is matched ignoring case and extra spaces, and the date in layout RFC3339Date is also accepted.
+//
+// Adding months or years to a date keeps the day of month if possible, otherwise it is the last day of the month,
+// so "in 1 month" of January 31 is the last day of February. A single weekday is the weekday on or after ref,
+// "this" weekday is the same, "next" weekday is the weekday after ref, and "last" weekday is the weekday before ref.
+func (g RelativeGrammar) Parse(s string, ref Date) (Date, error) {
+	date, err := ParseOptions{Mode: ParseStrict}.ParseDate(RFC3339Date, strings.TrimSpace(s))
+	if e, ok := err.(*ParseError); !ok || e.Kind != KindSyntax && e.Kind != KindTrailingData {
+		return date, err
+	}
+
+	words := strings.Fields(strings.ToLower(s))
+	date, ok, err := g.parse(words, ref)
+	if !ok {
+		return Date{}, fmt.Errorf("cannot parse %q as a relative date", s)
+	}
+	return date, err
+}
+
+func (g RelativeGrammar) parse(words []string, ref Date) (Date, bool, error) {
+	if len(words) == 0 {
+		return Date{}, false, nil
+	}
+
+	if days, ok := g.Days[strings.Join(words, " ")]; ok {
+		return ref.AddDays(days), true, nil
+	}
+
+	for _, dir := range [...]struct {
+		words []string
+		sign  int
+	}{{g.Future, 1}, {g.Past, -1}} {
+		if rest, ok := cutWords(words, oneOf(dir.words)); ok {
+			if n, unit, ok := g.amount(rest); ok {
+				return addRelative(ref, dir.sign*n, unit), true, nil
+			}
+		}
+		if rest, ok := cutWordsSuffix(words, oneOf(dir.words)); ok {
+			if n, unit, ok := g.amount(rest); ok {
+				return addRelative(ref, dir.sign*n, unit), true, nil
+			}
+		}
+	}
+
+	if sign, rest, ok := g.direction(words); ok {
+		if weekday, rest, ok := g.weekday(rest); ok && len(rest) == 0 {
+			switch sign {
+			case 1:
+				return ref.Next(weekday), true, nil
+			case -1:
+				return ref.Previous(weekday), true, nil
+			default:
+				return ref.NextOrSame(weekday), true, nil
+			}
+		}
+		if unit, rest, ok := g.unit(rest); ok && len(rest) == 0 {
+			return addRelative(ref, sign, unit), true, nil
+		}
+	}
+
+	if n, rest, ok := g.ordinal(words); ok {
+		if weekday, rest, ok := g.weekday(rest); ok {
+			if first, last, ok, err := g.period(rest, ref); ok {
+				if err != nil {
+					return Date{}, true, err
+				}
+				date, err := nthWeekdayIn(first, last, n, weekday)
+				return date, true, err
+			}
+		}
+		if unit, rest, ok := g.unit(rest); ok && unit == RelativeDay {
+			if first, last, ok, err := g.period(rest, ref); ok {
+				if err != nil {
+					return Date{}, true, err
+				}
+				date, err := nthDayIn(first, last, n)
+				return date, true, err
+			}
+		}
+	}
+
+	if weekday, rest, ok := g.weekday(words); ok && len(rest) == 0 {
+		return ref.NextOrSame(weekday), true, nil
+	}
+
+	return Date{}, false, nil
+}
+
+// oneOf returns a function which reports whether a phrase is one of the phrases.
+func oneOf(phrases []string) func(string) bool {
+	return func(phrase string) bool {
+		for _, p := range phrases {
+			if p == phrase {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// direction returns 1 for next, -1 for last and 0 for this, and the words after it.
+func (g RelativeGrammar) direction(words []string) (int, []string, bool) {
+	if rest, ok := cutWords(words, oneOf(g.Next)); ok {
+		return 1, rest, true
+	}
+	if rest, ok := cutWords(words, oneOf(g.Last)); ok {
+		return -1, rest, true
+	}
+	if rest, ok := cutWords(words, oneOf(g.This)); ok {
+		return 0, rest, true
+	}
+	return 0, words, false
+}
+
+// amount parses all the words as a number of units, such as "2 weeks".
+func (g RelativeGrammar) amount(words []string) (int, RelativeUnit, bool) {
+	if len(words) == 0 {
+		return 0, 0, false
+	}
+
+	n, s, ok := atoi(words[0], 1, 9)
+	rest := words[1:]
+	if !ok || s != "" {
+		rest, ok = cutWords(words, func(phrase string) bool {
+			n, ok = g.Numbers[phrase]
+			return ok
+		})
+		if !ok {
+			return 0, 0, false
+		}
+	}
+
+	unit, rest, ok := g.unit(rest)
+	if !ok || len(rest) != 0 {
+		return 0, 0, false
+	}
+	return n, unit, true
+}
+
+func (g RelativeGrammar) unit(words []string) (unit RelativeUnit, rest []string, ok bool) {
+	rest, ok = cutWords(words, func(phrase string) bool {
+		unit, ok = g.Units[phrase]
+		return ok
+	})
+	return unit, rest, ok
+}
+
+func (g RelativeGrammar) weekday(words []string) (weekday time.Weekday, rest []string, ok bool) {
+	rest, ok = cutWords(words, func(phrase string) bool {
+		weekday, ok = g.Weekdays[phrase]
+		return ok
+	})
+	return weekday, rest, ok
+}
+
+func (g RelativeGrammar) month(words []string) (month int, rest []string, ok bool) {
+	rest, ok = cutWords(words, func(phrase string) bool {
+		month, ok = g.Months[phrase]
+		return ok
+	})
+	return month, rest, ok
+}
+
+func (g RelativeGrammar) ordinal(words []string) (n int, rest []string, ok bool) {
+	rest, ok = cutWords(words, func(phrase string) bool {
+		n, ok = g.Ordinals[phrase]
+		return ok && n != 0
+	})
+	return n, rest, ok
+}
+
+// period parses all the words as <of> <period>, and returns the first and last days of the period.
+// The error is returned if the words are parsed but the month in the grammar is invalid.
+func (g RelativeGrammar) period(words []string, ref Date) (first, last Date, ok bool, err error) {
+	words, ok = cutWords(words, oneOf(g.Of))
+	if !ok {
+		return Date{}, Date{}, false, nil
+	}
+
+	if month, rest, ok := g.month(words); ok {
+		year := ref.Year()
+		switch len(rest) {
+		case 0:
+		case 1:
+			n, s, ok := atoi(rest[0], 1, 9)
+			if !ok || s != "" {
+				return Date{}, Date{}, false, nil
+			}
+			year = n
+		default:
+			return Date{}, Date{}, false, nil
+		}
+		ym, err := NewYearMonth(year, month)
+		if err != nil {
+			return Date{}, Date{}, true, err
+		}
+		return ym.FirstDay(), ym.LastDay(), true, nil
+	}
+
+	sign, rest, ok := g.direction(words)
+	if !ok {
+		return Date{}, Date{}, false, nil
+	}
+	unit, rest, ok := g.unit(rest)
+	if !ok || len(rest) != 0 {
+		return Date{}, Date{}, false, nil
+	}
+
+	switch unit {
+	case RelativeWeek:
+		first = ref.StartOfWeek(g.WeekStart).AddDays(7 * sign)
+		return first, first.AddDays(6), true, nil
+	case RelativeMonth:
+		ym := YearMonthOf(ref).Add(0, sign)
+		return ym.FirstDay(), ym.LastDay(), true, nil
+	case RelativeYear:
+		year := ref.Year() + sign
+		return Date{ordinal: calendarToOrdinal(year, 1, 1)}, Date{ordinal: calendarToOrdinal(year, 12, 31)}, true, nil
+	default:
+		return Date{}, Date{}, false, nil
+	}
+}
+
+// addRelative returns the date of adding n units to d, the day of month is clamped to the last day of the month.
+func addRelative(d Date, n int, unit RelativeUnit) Date {
+	switch unit {
+	case RelativeWeek:
+		return d.AddDays(7 * n)
+	case RelativeMonth, RelativeYear:
+		if unit == RelativeYear {
+			n *= 12
+		}
+		ym := YearMonthOf(d).Add(0, n)
+		if day := d.Day(); day <= ym.Days() {
+			return ym.FirstDay().AddDays(day - 1)
+		}
+		return ym.LastDay()
+	default:
+		return d.AddDays(n)
+	}
+}
+
+// nthWeekdayIn returns the n-th weekday between first and last, negative n counts from the end.
+func nthWeekdayIn(first, last Date, n int, weekday time.Weekday) (Date, error) {
+	var date Date
+	if n > 0 {
+		date = first.NextOrSame(weekday).AddDays(7 * (n - 1))
+	} else {
+		date = last.PreviousOrSame(weekday).AddDays(7 * (n + 1))
+	}
+	if date.Before(first) || date.After(last) {
+		return Date{}, errors.New("ordinal is out of range of the period")
+	}
+	return date, nil
+}
+
+// nthDayIn returns the n-th day between first and last, negative n counts from the end.
+func nthDayIn(first, last Date, n int) (Date, error) {
+	date := first.AddDays(n - 1)
+	if n < 0 {
+		date = last.AddDays(n + 1)
+	}
+	if date.Before(first) || date.After(last) {
+		return Date{}, errors.New("ordinal is out of range of the period")
+	}
+	return date, nil
+}
+
+// cutWords finds the longest prefix of words which is accepted, and returns the words after it.
+func cutWords(words []string, accept func(phrase string) bool) ([]string, bool) {
+	for n := len(words); n > 0; n-- {
+		if accept(strings.Join(words[:n], " ")) {
+			return words[n:], true
+		}
+	}
+	return words, false
+}
+
+// cutWordsSuffix finds the longest suffix of words which is accepted, and returns the words before it.
+func cutWordsSuffix(words []string, accept func(phrase string) bool) ([]string, bool) {
+	for n := 0; n < len(words); n++ {
+		if accept(strings.Join(words[n:], " ")) {
+			return words[:n], true
+		}
+	}
+	return words, false
+}
@@ -0,0 +1,112 @@
+package timex_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/invzhi/timex"
+)
+
+func TestParseRelativeDate(t *testing.T) {
+	ref := timex.MustNewDate(2024, 1, 31) // Wednesday.
+
+	tests := []struct {
+		value string
+		date  timex.Date
+	}{
+		{"today", ref},
+		{"Tomorrow", timex.MustNewDate(2024, 2, 1)},
+		{"yesterday", timex.MustNewDate(2024, 1, 30)},
+		{"day after  tomorrow", timex.MustNewDate(2024, 2, 2)},
+		{"in 2 weeks", timex.MustNewDate(2024, 2, 14)},
+		{"in a month", timex.MustNewDate(2024, 2, 29)},
+		{"in 1 year", timex.MustNewDate(2025, 1, 31)},
+		{"3 days ago", timex.MustNewDate(2024, 1, 28)},
+		{"two months ago", timex.MustNewDate(2023, 11, 30)},
+		{"10 days from now", timex.MustNewDate(2024, 2, 10)},
+		{"next monday", timex.MustNewDate(2024, 2, 5)},
+		{"next wednesday", timex.MustNewDate(2024, 2, 7)},
+		{"last wednesday", timex.MustNewDate(2024, 1, 24)},
+		{"this wednesday", ref},
+		{"friday", timex.MustNewDate(2024, 2, 2)},
+		{"next week", timex.MustNewDate(2024, 2, 7)},
+		{"last month", timex.MustNewDate(2023, 12, 31)},
+		{"next year", timex.MustNewDate(2025, 1, 31)},
+		{"last day of next month", timex.MustNewDate(2024, 2, 29)},
+		{"first day of this year", timex.MustNewDate(2024, 1, 1)},
+		{"first day of next week", timex.MustNewDate(2024, 2, 5)},
+		{"first Tuesday of March", timex.MustNewDate(2024, 3, 5)},
+		{"last friday of march 2025", timex.MustNewDate(2025, 3, 28)},
+		{"2nd monday of last month", timex.MustNewDate(2023, 12, 11)},
+		{"2024-02-29", timex.MustNewDate(2024, 2, 29)},
+	}
+
+	for _, tt := range tests {
+		date, err := timex.ParseRelativeDate(tt.value, ref)
+		assert.NoError(t, err, tt.value)
+		assert.Equal(t, tt.date, date, tt.value)
+	}
+
+	t.Run("Errors", func(t *testing.T) {
+		tests := []struct {
+			value string
+			err   string
+		}{
+			{"", `cannot parse "" as a relative date`},
+			{"someday", `cannot parse "someday" as a relative date`},
+			{"in 2 fortnights", `cannot parse "in 2 fortnights" as a relative date`},
+			{"next monday please", `cannot parse "next monday please" as a relative date`},
+			{"fifth monday of february", "ordinal is out of range of the period"},
+			{"2023-02-29", `parsing "2023-02-29" as "YYYY-MM-DD": day is out of range [1,28]`},
+			{"2024-01-01 garbage", `cannot parse "2024-01-01 garbage" as a relative date`},
+			{"2024-01-0123", `cannot parse "2024-01-0123" as a relative date`},
+		}
+
+		for _, tt := range tests {
+			_, err := timex.ParseRelativeDate(tt.value, ref)
+			assert.EqualError(t, err, tt.err, tt.value)
+		}
+	})
+
+	t.Run("Grammar", func(t *testing.T) {
+		grammar := timex.RelativeGrammar{
+			Days:     map[string]int{"heute": 0, "morgen": 1, "übermorgen": 2},
+			Next:     []string{"nächsten", "nächste"},
+			Last:     []string{"letzten", "letzte"},
+			Future:   []string{"in"},
+			Past:     []string{"vor"},
+			Of:       []string{"im"},
+			Numbers:  map[string]int{"einer": 1, "zwei": 2},
+			Ordinals: map[string]int{"erster": 1, "letzter": -1},
+			Units: map[string]timex.RelativeUnit{
+				"tag": timex.RelativeDay, "tagen": timex.RelativeDay,
+				"woche": timex.RelativeWeek, "wochen": timex.RelativeWeek,
+			},
+			Weekdays: map[string]time.Weekday{"montag": time.Monday, "dienstag": time.Tuesday},
+			Months:   map[string]int{"märz": 3},
+		}
+
+		tests := []struct {
+			value string
+			date  timex.Date
+		}{
+			{"Übermorgen", timex.MustNewDate(2024, 2, 2)},
+			{"in zwei Wochen", timex.MustNewDate(2024, 2, 14)},
+			{"vor 3 Tagen", timex.MustNewDate(2024, 1, 28)},
+			{"nächsten Montag", timex.MustNewDate(2024, 2, 5)},
+			{"erster Dienstag im März", timex.MustNewDate(2024, 3, 5)},
+		}
+
+		for _, tt := range tests {
+			date, err := grammar.Parse(tt.value, ref)
+			assert.NoError(t, err, tt.value)
+			assert.Equal(t, tt.date, date, tt.value)
+		}
+
+		grammar.Months["undecimber"] = 13
+		_, err := grammar.Parse("erster Montag im Undecimber", ref)
+		assert.EqualError(t, err, "month is out of range [1,12]")
+	})
+}
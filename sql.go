@@ -52,12 +52,14 @@ func (d NullDate) Value() (driver.Value, error) {
 }
 
 // Scan implements the sql.Scanner interface.
+// The value 24:00:00 written by Value for EndOfDay, which is also the end of day of the TIME type of PostgreSQL,
+// is accepted as EndOfDay, the other values out of range such as leap seconds are not accepted.
 func (t *TimeOfDay) Scan(value interface{}) (err error) {
 	switch v := value.(type) {
 	case []byte:
-		*t, err = ParseTimeOfDay(RFC3339Time, string(v))
+		*t, err = scanTimeOfDay(string(v))
 	case string:
-		*t, err = ParseTimeOfDay(RFC3339Time, v)
+		*t, err = scanTimeOfDay(v)
 	case time.Time:
 		*t = TimeOfDayFromTime(v)
 	default:
@@ -66,7 +68,16 @@ func (t *TimeOfDay) Scan(value interface{}) (err error) {
 	return err
 }
 
+// scanTimeOfDay parses the time of day in RFC 3339 format, and 24:00:00 as EndOfDay.
+func scanTimeOfDay(s string) (TimeOfDay, error) {
+	if s == "24:00:00" {
+		return EndOfDay(), nil
+	}
+	return ParseTimeOfDay(RFC3339Time, s)
+}
+
 // Value implements the driver.Valuer interface.
+// EndOfDay is written as 24:00:00, which is read back by Scan.
 func (t TimeOfDay) Value() (driver.Value, error) {
 	return t.Format(RFC3339Time), nil
 }
@@ -95,3 +106,183 @@ func (t NullTimeOfDay) Value() (driver.Value, error) {
 	}
 	return t.TimeOfDay.Value()
 }
+
+// Scan implements the sql.Scanner interface.
+func (ym *YearMonth) Scan(value interface{}) (err error) {
+	switch v := value.(type) {
+	case []byte:
+		*ym, err = ParseYearMonth(ISO8601YearMonth, string(v))
+	case string:
+		*ym, err = ParseYearMonth(ISO8601YearMonth, v)
+	case time.Time:
+		*ym = YearMonthOf(DateFromTime(v))
+	default:
+		err = fmt.Errorf("unsupported type %T", value)
+	}
+	return err
+}
+
+// Value implements the driver.Valuer interface.
+func (ym YearMonth) Value() (driver.Value, error) {
+	return ym.Format(ISO8601YearMonth), nil
+}
+
+// NullYearMonth represents a specific month of a year in Gregorian calendar that may be null.
+// NullYearMonth implements the sql.Scanner interface, so it can be used as a scan destination, similar to sql.NullString.
+type NullYearMonth struct {
+	YearMonth YearMonth
+	Valid     bool // Valid is true if YearMonth is not NULL.
+}
+
+// Scan implements the sql.Scanner interface.
+func (ym *NullYearMonth) Scan(value interface{}) error {
+	if value == nil {
+		ym.YearMonth, ym.Valid = YearMonth{}, false
+		return nil
+	}
+	ym.Valid = true
+	return ym.YearMonth.Scan(value)
+}
+
+// Value implements the driver.Valuer interface.
+func (ym NullYearMonth) Value() (driver.Value, error) {
+	if !ym.Valid {
+		return nil, nil
+	}
+	return ym.YearMonth.Value()
+}
+
+// Scan implements the sql.Scanner interface.
+func (md *MonthDay) Scan(value interface{}) (err error) {
+	switch v := value.(type) {
+	case []byte:
+		*md, err = ParseMonthDay(ISO8601MonthDay, string(v))
+	case string:
+		*md, err = ParseMonthDay(ISO8601MonthDay, v)
+	case time.Time:
+		*md = MonthDayOf(DateFromTime(v))
+	default:
+		err = fmt.Errorf("unsupported type %T", value)
+	}
+	return err
+}
+
+// Value implements the driver.Valuer interface.
+func (md MonthDay) Value() (driver.Value, error) {
+	return md.String(), nil
+}
+
+// NullMonthDay represents a specific day of a month without year that may be null.
+// NullMonthDay implements the sql.Scanner interface, so it can be used as a scan destination, similar to sql.NullString.
+type NullMonthDay struct {
+	MonthDay MonthDay
+	Valid    bool // Valid is true if MonthDay is not NULL.
+}
+
+// Scan implements the sql.Scanner interface.
+func (md *NullMonthDay) Scan(value interface{}) error {
+	if value == nil {
+		md.MonthDay, md.Valid = MonthDay{}, false
+		return nil
+	}
+	md.Valid = true
+	return md.MonthDay.Scan(value)
+}
+
+// Value implements the driver.Valuer interface.
+func (md NullMonthDay) Value() (driver.Value, error) {
+	if !md.Valid {
+		return nil, nil
+	}
+	return md.MonthDay.Value()
+}
+
+// Scan implements the sql.Scanner interface.
+func (yq *YearQuarter) Scan(value interface{}) (err error) {
+	switch v := value.(type) {
+	case []byte:
+		*yq, err = ParseYearQuarter(string(v))
+	case string:
+		*yq, err = ParseYearQuarter(v)
+	case time.Time:
+		*yq = YearQuarterOf(DateFromTime(v))
+	default:
+		err = fmt.Errorf("unsupported type %T", value)
+	}
+	return err
+}
+
+// Value implements the driver.Valuer interface.
+func (yq YearQuarter) Value() (driver.Value, error) {
+	return yq.String(), nil
+}
+
+// NullYearQuarter represents a specific quarter of a year in Gregorian calendar that may be null.
+// NullYearQuarter implements the sql.Scanner interface, so it can be used as a scan destination, similar to sql.NullString.
+type NullYearQuarter struct {
+	YearQuarter YearQuarter
+	Valid       bool // Valid is true if YearQuarter is not NULL.
+}
+
+// Scan implements the sql.Scanner interface.
+func (yq *NullYearQuarter) Scan(value interface{}) error {
+	if value == nil {
+		yq.YearQuarter, yq.Valid = YearQuarter{}, false
+		return nil
+	}
+	yq.Valid = true
+	return yq.YearQuarter.Scan(value)
+}
+
+// Value implements the driver.Valuer interface.
+func (yq NullYearQuarter) Value() (driver.Value, error) {
+	if !yq.Valid {
+		return nil, nil
+	}
+	return yq.YearQuarter.Value()
+}
+
+// Scan implements the sql.Scanner interface.
+func (yw *YearWeek) Scan(value interface{}) (err error) {
+	switch v := value.(type) {
+	case []byte:
+		*yw, err = ParseYearWeek(string(v))
+	case string:
+		*yw, err = ParseYearWeek(v)
+	case time.Time:
+		*yw = YearWeekOf(DateFromTime(v))
+	default:
+		err = fmt.Errorf("unsupported type %T", value)
+	}
+	return err
+}
+
+// Value implements the driver.Valuer interface.
+func (yw YearWeek) Value() (driver.Value, error) {
+	return yw.String(), nil
+}
+
+// NullYearWeek represents a specific week of a week-based year in ISO 8601 that may be null.
+// NullYearWeek implements the sql.Scanner interface, so it can be used as a scan destination, similar to sql.NullString.
+type NullYearWeek struct {
+	YearWeek YearWeek
+	Valid    bool // Valid is true if YearWeek is not NULL.
+}
+
+// Scan implements the sql.Scanner interface.
+func (yw *NullYearWeek) Scan(value interface{}) error {
+	if value == nil {
+		yw.YearWeek, yw.Valid = YearWeek{}, false
+		return nil
+	}
+	yw.Valid = true
+	return yw.YearWeek.Scan(value)
+}
+
+// Value implements the driver.Valuer interface.
+func (yw NullYearWeek) Value() (driver.Value, error) {
+	if !yw.Valid {
+		return nil, nil
+	}
+	return yw.YearWeek.Value()
+}
@@ -124,6 +124,8 @@ func TestTimeOfDayScan(t *testing.T) {
 func TestTimeOfDayScanErrors(t *testing.T) {
 	assert.EqualError(t, new(timex.TimeOfDay).Scan(nil), "unsupported type <nil>")
 	assert.EqualError(t, new(timex.TimeOfDay).Scan(uint64(1)), "unsupported type uint64")
+	assert.EqualError(t, new(timex.TimeOfDay).Scan("24:00:01"), `parsing "24:00:01" as "HH:mm:ss": hour is out of range [0,23]`)
+	assert.EqualError(t, new(timex.TimeOfDay).Scan([]byte("23:59:60")), `parsing "23:59:60" as "HH:mm:ss": second is out of range [0,59]`)
 
 	t.Run("NullTimeOfDay", func(t *testing.T) {
 		assert.EqualError(t, new(timex.NullTimeOfDay).Scan(uint64(1)), "unsupported type uint64")
@@ -151,6 +153,18 @@ func TestTimeOfDayValue(t *testing.T) {
 		assert.Equal(t, tt.value, value)
 	}
 
+	t.Run("EndOfDay", func(t *testing.T) {
+		value, err := timex.EndOfDay().Value()
+		assert.NoError(t, err)
+		assert.Equal(t, "24:00:00", value)
+
+		var timeOfDay timex.TimeOfDay
+		assert.NoError(t, timeOfDay.Scan(value))
+		assert.Equal(t, timex.EndOfDay(), timeOfDay)
+		assert.NoError(t, timeOfDay.Scan([]byte("24:00:00")))
+		assert.Equal(t, timex.EndOfDay(), timeOfDay)
+	})
+
 	t.Run("NullTimeOfDay", func(t *testing.T) {
 		value, err := timex.NullTimeOfDay{}.Value()
 		assert.NoError(t, err)
@@ -164,3 +178,248 @@ func TestTimeOfDayValue(t *testing.T) {
 		}
 	})
 }
+
+func TestYearMonthScan(t *testing.T) {
+	tests := []struct {
+		value interface{}
+		s     string
+	}{
+		{[]byte("2024-03"), "2024-03"},
+		{"2024-03", "2024-03"},
+		{"2024-03-01", "2024-03"},
+		{time.Date(2024, 3, 15, 0, 0, 0, 0, time.UTC), "2024-03"},
+	}
+
+	for _, tt := range tests {
+		var ym timex.YearMonth
+		err := ym.Scan(tt.value)
+		assert.NoError(t, err)
+
+		assert.Equal(t, tt.s, ym.Format(timex.ISO8601YearMonth))
+	}
+
+	t.Run("NullYearMonth", func(t *testing.T) {
+		var ym timex.NullYearMonth
+		err := ym.Scan(nil)
+		assert.NoError(t, err)
+		assert.False(t, ym.Valid)
+
+		for _, tt := range tests {
+			err = ym.Scan(tt.value)
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.s, ym.YearMonth.Format(timex.ISO8601YearMonth))
+			assert.True(t, ym.Valid)
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		assert.EqualError(t, new(timex.YearMonth).Scan(nil), "unsupported type <nil>")
+		assert.EqualError(t, new(timex.YearMonth).Scan(uint64(1)), "unsupported type uint64")
+		assert.EqualError(t, new(timex.NullYearMonth).Scan(uint64(1)), "unsupported type uint64")
+	})
+}
+
+func TestYearMonthValue(t *testing.T) {
+	tests := []struct {
+		ym    timex.YearMonth
+		value interface{}
+	}{
+		{timex.YearMonth{}, "0001-01"},
+		{timex.MustNewYearMonth(2024, 3), "2024-03"},
+	}
+
+	for _, tt := range tests {
+		value, err := tt.ym.Value()
+		assert.NoError(t, err)
+		assert.Equal(t, tt.value, value)
+	}
+
+	t.Run("NullYearMonth", func(t *testing.T) {
+		value, err := timex.NullYearMonth{}.Value()
+		assert.NoError(t, err)
+		assert.Nil(t, value)
+
+		for _, tt := range tests {
+			ym := timex.NullYearMonth{YearMonth: tt.ym, Valid: true}
+			value, err := ym.Value()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.value, value)
+		}
+	})
+}
+
+func TestMonthDayScan(t *testing.T) {
+	tests := []struct {
+		value interface{}
+		s     string
+	}{
+		{[]byte("--02-29"), "--02-29"},
+		{"--02-29", "--02-29"},
+		{time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC), "--02-29"},
+	}
+
+	for _, tt := range tests {
+		var md timex.MonthDay
+		err := md.Scan(tt.value)
+		assert.NoError(t, err)
+
+		assert.Equal(t, tt.s, md.Format(timex.ISO8601MonthDay))
+	}
+
+	t.Run("NullMonthDay", func(t *testing.T) {
+		var md timex.NullMonthDay
+		err := md.Scan(nil)
+		assert.NoError(t, err)
+		assert.False(t, md.Valid)
+
+		for _, tt := range tests {
+			err = md.Scan(tt.value)
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.s, md.MonthDay.Format(timex.ISO8601MonthDay))
+			assert.True(t, md.Valid)
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		assert.EqualError(t, new(timex.MonthDay).Scan(nil), "unsupported type <nil>")
+		assert.EqualError(t, new(timex.MonthDay).Scan(uint64(1)), "unsupported type uint64")
+		assert.EqualError(t, new(timex.NullMonthDay).Scan(uint64(1)), "unsupported type uint64")
+	})
+}
+
+func TestMonthDayValue(t *testing.T) {
+	tests := []struct {
+		md    timex.MonthDay
+		value interface{}
+	}{
+		{timex.MonthDay{}, "--01-01"},
+		{timex.MustNewMonthDay(2, 29), "--02-29"},
+	}
+
+	for _, tt := range tests {
+		value, err := tt.md.Value()
+		assert.NoError(t, err)
+		assert.Equal(t, tt.value, value)
+	}
+
+	t.Run("NullMonthDay", func(t *testing.T) {
+		value, err := timex.NullMonthDay{}.Value()
+		assert.NoError(t, err)
+		assert.Nil(t, value)
+
+		for _, tt := range tests {
+			md := timex.NullMonthDay{MonthDay: tt.md, Valid: true}
+			value, err := md.Value()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.value, value)
+		}
+	})
+}
+
+func TestYearQuarterScan(t *testing.T) {
+	tests := []struct {
+		value interface{}
+		s     string
+	}{
+		{[]byte("2024-Q1"), "2024-Q1"},
+		{"2024-Q1", "2024-Q1"},
+		{time.Date(2024, 3, 31, 0, 0, 0, 0, time.UTC), "2024-Q1"},
+	}
+
+	for _, tt := range tests {
+		var yq timex.YearQuarter
+		err := yq.Scan(tt.value)
+		assert.NoError(t, err)
+
+		assert.Equal(t, tt.s, yq.String())
+	}
+
+	t.Run("NullYearQuarter", func(t *testing.T) {
+		var yq timex.NullYearQuarter
+		err := yq.Scan(nil)
+		assert.NoError(t, err)
+		assert.False(t, yq.Valid)
+
+		for _, tt := range tests {
+			err = yq.Scan(tt.value)
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.s, yq.YearQuarter.String())
+			assert.True(t, yq.Valid)
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		assert.EqualError(t, new(timex.YearQuarter).Scan(nil), "unsupported type <nil>")
+		assert.EqualError(t, new(timex.NullYearQuarter).Scan(uint64(1)), "unsupported type uint64")
+	})
+}
+
+func TestYearQuarterValue(t *testing.T) {
+	value, err := timex.MustNewYearQuarter(2024, 1).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-Q1", value)
+
+	value, err = timex.NullYearQuarter{}.Value()
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+
+	value, err = timex.NullYearQuarter{YearQuarter: timex.MustNewYearQuarter(2024, 1), Valid: true}.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-Q1", value)
+}
+
+func TestYearWeekScan(t *testing.T) {
+	tests := []struct {
+		value interface{}
+		s     string
+	}{
+		{[]byte("2024-W05"), "2024-W05"},
+		{"2024-W05", "2024-W05"},
+		{time.Date(2024, 2, 4, 0, 0, 0, 0, time.UTC), "2024-W05"},
+	}
+
+	for _, tt := range tests {
+		var yw timex.YearWeek
+		err := yw.Scan(tt.value)
+		assert.NoError(t, err)
+
+		assert.Equal(t, tt.s, yw.String())
+	}
+
+	t.Run("NullYearWeek", func(t *testing.T) {
+		var yw timex.NullYearWeek
+		err := yw.Scan(nil)
+		assert.NoError(t, err)
+		assert.False(t, yw.Valid)
+
+		for _, tt := range tests {
+			err = yw.Scan(tt.value)
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.s, yw.YearWeek.String())
+			assert.True(t, yw.Valid)
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		assert.EqualError(t, new(timex.YearWeek).Scan(nil), "unsupported type <nil>")
+		assert.EqualError(t, new(timex.NullYearWeek).Scan(uint64(1)), "unsupported type uint64")
+	})
+}
+
+func TestYearWeekValue(t *testing.T) {
+	value, err := timex.MustNewYearWeek(2024, 5).Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-W05", value)
+
+	value, err = timex.NullYearWeek{}.Value()
+	assert.NoError(t, err)
+	assert.Nil(t, value)
+
+	value, err = timex.NullYearWeek{YearWeek: timex.MustNewYearWeek(2024, 5), Valid: true}.Value()
+	assert.NoError(t, err)
+	assert.Equal(t, "2024-W05", value)
+}
@@ -0,0 +1,337 @@
+package timex
+
+import (
+	"strings"
+	"time"
+)
+
+var middayNames = []string{"AM", "PM"}
+
+// FormatStrftime returns a textual representation of the date d and the time of day t in a format
+// of C strftime, which is also used by Python and PostgreSQL tooling.
+//
+//	%Y   2001             Four-digit year
+//	%y     01             Two-digit year
+//	%m  01-12             Month, 2-digits
+//	%d  01-31             Day of month, 2-digits
+//	%b  Jan-Dec           The abbreviated month name
+//	%B  January-December  The full month name
+//	%a  Sun-Sat           The abbreviated weekday name
+//	%A  Sunday-Saturday   The full weekday name
+//	%j  001-366           Day of year, 3-digits
+//	%U  00-53             Week of year, weeks start on Sunday, the days before the first Sunday are in week 0
+//	%W  00-53             Week of year, weeks start on Monday, the days before the first Monday are in week 0
+//	%V  01-53             ISO 8601 week, 2-digits
+//	%G   2001             Four-digit ISO 8601 week-based year
+//	%u    1-7             ISO 8601 day of week, beginning at 1 on Monday
+//	%H  00-23             Hour, 24-hour clock
+//	%I  01-12             Hour, 12-hour clock
+//	%p  AM/PM             Ante meridiem or post meridiem
+//	%M  00-59             Minute
+//	%S  00-59             Second
+//	%f  000000-999999     Microsecond, 6-digits
+//	%%  %                 A literal %
+//
+// The other characters, including the unknown directives, are copied to the result.
+func FormatStrftime(format string, d Date, t TimeOfDay) string {
+	year, month, day := ordinalToCalendar(d.ordinal)
+	hour, min, sec, nsec := nanosecondsToTime(t.n)
+
+	bytes := make([]byte, 0, len(format)+10)
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' || i+1 == len(format) {
+			bytes = append(bytes, format[i])
+			continue
+		}
+
+		i++
+		switch format[i] {
+		case 'Y':
+			bytes = appendInt(bytes, year, 4)
+		case 'y':
+			bytes = appendInt(bytes, floorMod(year, 100), 2)
+		case 'm':
+			bytes = appendInt(bytes, month, 2)
+		case 'd':
+			bytes = appendInt(bytes, day, 2)
+		case 'b':
+			bytes = append(bytes, monthShortNames[month-1]...)
+		case 'B':
+			bytes = append(bytes, monthLongNames[month-1]...)
+		case 'a':
+			bytes = append(bytes, d.Weekday().String()[:3]...)
+		case 'A':
+			bytes = append(bytes, d.Weekday().String()...)
+		case 'j':
+			bytes = appendInt(bytes, d.DayOfYear(), 3)
+		case 'U':
+			bytes = appendInt(bytes, weekOfYear(d, time.Sunday), 2)
+		case 'W':
+			bytes = appendInt(bytes, weekOfYear(d, time.Monday), 2)
+		case 'V':
+			_, week := d.ISOWeek()
+			bytes = appendInt(bytes, week, 2)
+		case 'G':
+			year, _ := d.ISOWeek()
+			bytes = appendInt(bytes, year, 4)
+		case 'u':
+			bytes = appendInt(bytes, isoWeekScheme.DayOfWeek(d), 0)
+		case 'H':
+			bytes = appendInt(bytes, hour, 2)
+		case 'I':
+			bytes = appendInt(bytes, hour12(hour), 2)
+		case 'p':
+			bytes = append(bytes, midday(hour, "AM", "PM")...)
+		case 'M':
+			bytes = appendInt(bytes, min, 2)
+		case 'S':
+			bytes = appendInt(bytes, sec, 2)
+		case 'f':
+			bytes = appendInt(bytes, nsec/1e3, 6)
+		case '%':
+			bytes = append(bytes, '%')
+		default:
+			bytes = append(bytes, '%', format[i])
+		}
+	}
+	return string(bytes)
+}
+
+// FormatStrftime returns a textual representation of the date in a format of C strftime, see FormatStrftime.
+// The directives of time are formatted as midnight.
+func (d Date) FormatStrftime(format string) string {
+	return FormatStrftime(format, d, TimeOfDay{})
+}
+
+// FormatStrftime returns a textual representation of the time of day in a format of C strftime, see FormatStrftime.
+// The directives of date are formatted as January 1 of year 1.
+func (t TimeOfDay) FormatStrftime(format string) string {
+	return FormatStrftime(format, Date{}, t)
+}
+
+// weekOfYear returns the week of year specified by d, the weeks start on firstDay,
+// and the days before the first firstDay of the year are in week 0.
+func weekOfYear(d Date, firstDay time.Weekday) int {
+	offset := (int(d.Weekday()) - int(firstDay) + 7) % 7
+	return (d.DayOfYear() - 1 + 7 - offset) / 7
+}
+
+// The directives parsed by ParseStrftime.
+const (
+	strftimeYear = 1 << iota
+	strftimeYearDay
+	strftimeWeekSunday
+	strftimeWeekMonday
+	strftimeISOWeek
+	strftimeWeekday
+)
+
+// ParseStrftime parses a formatted string in a format of C strptime and returns the date and the time of day it represents.
+// The format uses the directives of FormatStrftime, the names are matched case-insensitively,
+// and a space in the format matches one or more spaces. The value must not have data after the format.
+//
+// The date is built from the ISO 8601 week if %V is parsed, from the day of year if %j is parsed,
+// from the week of year if %U or %W is parsed, otherwise from the year, month and day.
+// The week-based year defaults to the year, the day of week defaults to the first day of week, the year defaults to 1,
+// the month and day default to 1, and the elements of time default to 0.
+// The weekday names are not checked against the date built from the year, month and day.
+func ParseStrftime(format, value string) (Date, TimeOfDay, error) {
+	var f dateFields
+	var yearDay, weekSunday, weekMonday, hour, min, sec, nsec int
+	var weekday time.Weekday
+	var parsed int
+	var amSet, pmSet bool
+	var spans elemSpans
+	var yearDaySpan, weekOfYearSpan elemSpan // the spans of %j and %U or %W, which are used if they build the date.
+
+	f.year, f.month, f.day = 1, 1, 1
+
+	originValue := value
+	for i := 0; i < len(format); i++ {
+		c := format[i]
+		if c == ' ' {
+			n := len(value) - len(strings.TrimLeft(value, " \t\n\r\v\f"))
+			if n == 0 {
+				return Date{}, TimeOfDay{}, &ParseError{Layout: format, Value: originValue, LayoutElem: " ", ValueElem: value, Offset: len(originValue) - len(value)}
+			}
+			value = value[n:]
+			continue
+		}
+		if c != '%' || i+1 == len(format) || format[i+1] == '%' {
+			if c == '%' && i+1 < len(format) {
+				i++
+			}
+			if len(value) == 0 || value[0] != c {
+				return Date{}, TimeOfDay{}, &ParseError{Layout: format, Value: originValue, LayoutElem: string(c), ValueElem: value, Offset: len(originValue) - len(value)}
+			}
+			value = value[1:]
+			continue
+		}
+
+		i++
+		directive, valueElem := format[i-1:i+1], value
+		var ok bool
+
+		switch format[i] {
+		case 'Y':
+			f.year, value, ok = atoi(value, 4, 4)
+			parsed |= strftimeYear
+		case 'y':
+			f.year, value, ok = atoi(value, 2, 2)
+			f.year = ParseOptions{}.twoDigitYear(f.year)
+			parsed |= strftimeYear
+		case 'm':
+			f.month, value, ok = atoi(value, 1, 2)
+		case 'd':
+			f.day, value, ok = atoi(value, 1, 2)
+		case 'b':
+			var index int
+			index, value, ok = searchName(monthShortNames, value)
+			f.month = index + 1
+		case 'B':
+			var index int
+			index, value, ok = searchName(monthLongNames, value)
+			f.month = index + 1
+		case 'a', 'A':
+			var index int
+			index, value, ok = searchName(weekdayNames(format[i] == 'a'), value)
+			weekday = time.Weekday(index)
+			parsed |= strftimeWeekday
+		case 'j':
+			yearDay, value, ok = atoi(value, 1, 3)
+			parsed |= strftimeYearDay
+		case 'U':
+			weekSunday, value, ok = atoi(value, 1, 2)
+			parsed |= strftimeWeekSunday
+		case 'W':
+			weekMonday, value, ok = atoi(value, 1, 2)
+			parsed |= strftimeWeekMonday
+		case 'V':
+			f.week, value, ok = atoi(value, 1, 2)
+			parsed |= strftimeISOWeek
+		case 'G':
+			f.weekYear, value, ok = atoi(value, 4, 4)
+			f.hasWeekYear = true
+		case 'u':
+			var n int
+			n, value, ok = atoi(value, 1, 1)
+			ok = ok && n >= 1 && n <= 7
+			weekday = time.Weekday(n % 7)
+			parsed |= strftimeWeekday
+		case 'H':
+			hour, value, ok = atoi(value, 1, 2)
+		case 'I':
+			hour, value, ok = atoi(value, 1, 2)
+			ok = ok && hour >= 1 && hour <= 12
+		case 'p':
+			var index int
+			index, value, ok = searchName(middayNames, value)
+			amSet, pmSet = index == 0, index == 1
+		case 'M':
+			min, value, ok = atoi(value, 1, 2)
+		case 'S':
+			sec, value, ok = atoi(value, 1, 2)
+		case 'f':
+			rest := strings.TrimLeft(value, "0123456789")
+			digits := len(value) - len(rest)
+			if digits > 6 {
+				digits = 6
+			}
+			nsec, value, ok = atoi(value, 1, digits)
+			nsec *= pow10(9 - digits)
+		default:
+			ok = strings.HasPrefix(value, directive)
+			value = strings.TrimPrefix(value, directive)
+		}
+
+		if !ok {
+			return Date{}, TimeOfDay{}, &ParseError{Layout: format, Value: originValue, LayoutElem: directive, ValueElem: valueElem, Offset: len(originValue) - len(valueElem)}
+		}
+
+		span := elemSpan{layoutElem: directive, valueElem: valueElem[:len(valueElem)-len(value)], offset: len(originValue) - len(valueElem)}
+		switch format[i] {
+		case 'Y', 'y', 'G':
+			spans[KindYearOutOfRange] = span
+		case 'm', 'b', 'B':
+			spans[KindMonthOutOfRange] = span
+		case 'd':
+			spans[KindDayOutOfRange] = span
+		case 'j':
+			yearDaySpan = span
+		case 'V':
+			spans[KindWeekOutOfRange] = span
+		case 'U', 'W':
+			weekOfYearSpan = span
+		case 'H', 'I':
+			spans[KindHourOutOfRange] = span
+		case 'M':
+			spans[KindMinuteOutOfRange] = span
+		case 'S':
+			spans[KindSecondOutOfRange] = span
+		case 'f':
+			spans[KindNanosecondOutOfRange] = span
+		}
+	}
+
+	if value != "" {
+		offset := len(originValue) - len(value)
+		return Date{}, TimeOfDay{}, &ParseError{Layout: format, Value: originValue, ValueElem: value, Offset: offset, Kind: KindTrailingData}
+	}
+
+	if amSet && hour == 12 {
+		hour = 0
+	} else if pmSet && hour < 12 {
+		hour += 12
+	}
+	t, err := NewTimeOfDay(hour, min, sec, nsec)
+	if err != nil {
+		return Date{}, TimeOfDay{}, spans.parseError(format, originValue, err)
+	}
+
+	var d Date
+	switch {
+	case parsed&strftimeISOWeek != 0:
+		if !f.hasWeekYear {
+			f.weekYear = f.year
+		}
+		if parsed&strftimeWeekday == 0 {
+			weekday = time.Monday
+		}
+		d, err = isoWeekScheme.Date(f.weekYear, f.week, weekday)
+	case parsed&strftimeYearDay != 0:
+		spans[KindDayOutOfRange] = yearDaySpan
+		d, err = DateFromOrdinalDate(f.year, yearDay)
+	case parsed&(strftimeWeekSunday|strftimeWeekMonday) != 0:
+		firstDay, week := time.Sunday, weekSunday
+		if parsed&strftimeWeekMonday != 0 {
+			firstDay, week = time.Monday, weekMonday
+		}
+		if parsed&strftimeWeekday == 0 {
+			weekday = firstDay
+		}
+		if week > 53 {
+			spans[KindWeekOutOfRange] = weekOfYearSpan
+			return Date{}, TimeOfDay{}, spans.parseError(format, originValue, rangeError(KindWeekOutOfRange, week, 0, 53))
+		}
+		first := Date{ordinal: calendarToOrdinal(f.year, 1, 1)}.NextOrSame(firstDay)
+		d = first.AddDays(7*(week-1) + (int(weekday)-int(firstDay)+7)%7)
+	default:
+		d, err = NewDate(f.year, f.month, f.day)
+	}
+	if err != nil {
+		return Date{}, TimeOfDay{}, spans.parseError(format, originValue, err)
+	}
+	return d, t, nil
+}
+
+// weekdayNames returns the abbreviated or full English names of weekdays from Sunday.
+func weekdayNames(short bool) []string {
+	names := make([]string, 7)
+	for i := range names {
+		names[i] = time.Weekday(i).String()
+		if short {
+			names[i] = names[i][:3]
+		}
+	}
+	return names
+}
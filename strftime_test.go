@@ -0,0 +1,137 @@
+package timex_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/invzhi/timex"
+)
+
+func TestFormatStrftime(t *testing.T) {
+	tests := []struct {
+		format string
+		date   timex.Date
+		t      timex.TimeOfDay
+		value  string
+	}{
+		{"%Y-%m-%d %H:%M:%S", timex.MustNewDate(2024, 3, 5), timex.MustNewTimeOfDay(9, 5, 7, 0), "2024-03-05 09:05:07"},
+		{"%a, %d %b %Y", timex.MustNewDate(2024, 3, 5), timex.TimeOfDay{}, "Tue, 05 Mar 2024"},
+		{"%A %B %d, %y", timex.MustNewDate(2024, 3, 5), timex.TimeOfDay{}, "Tuesday March 05, 24"},
+		{"%j %U %W %V %G %u", timex.MustNewDate(2024, 3, 5), timex.TimeOfDay{}, "065 09 10 10 2024 2"},
+		{"%j %U %W %V %G %u", timex.MustNewDate(2023, 1, 1), timex.TimeOfDay{}, "001 01 00 52 2022 7"},
+		{"%j %U %W %V %G %u", timex.MustNewDate(2024, 12, 30), timex.TimeOfDay{}, "365 52 53 01 2025 1"},
+		{"%I:%M %p", timex.MustNewDate(2024, 3, 5), timex.MustNewTimeOfDay(0, 30, 0, 0), "12:30 AM"},
+		{"%I:%M %p", timex.MustNewDate(2024, 3, 5), timex.MustNewTimeOfDay(21, 5, 0, 0), "09:05 PM"},
+		{"%H:%M:%S.%f", timex.Date{}, timex.MustNewTimeOfDay(12, 34, 56, 123456789), "12:34:56.123456"},
+		{"100%% %Q", timex.Date{}, timex.TimeOfDay{}, "100% %Q"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.value, timex.FormatStrftime(tt.format, tt.date, tt.t), tt.format)
+	}
+
+	assert.Equal(t, "2024-03-05T00:00:00", timex.MustNewDate(2024, 3, 5).FormatStrftime("%Y-%m-%dT%H:%M:%S"))
+	assert.Equal(t, "09:05 PM", timex.MustNewTimeOfDay(21, 5, 0, 0).FormatStrftime("%I:%M %p"))
+}
+
+func TestParseStrftime(t *testing.T) {
+	tests := []struct {
+		format string
+		value  string
+		date   timex.Date
+		t      timex.TimeOfDay
+	}{
+		{"%Y-%m-%d %H:%M:%S", "2024-03-05 09:05:07", timex.MustNewDate(2024, 3, 5), timex.MustNewTimeOfDay(9, 5, 7, 0)},
+		{"%Y-%m-%d %H:%M:%S", "2024-3-5   9:5:7", timex.MustNewDate(2024, 3, 5), timex.MustNewTimeOfDay(9, 5, 7, 0)},
+		{"%a, %d %b %Y", "tue, 05 MAR 2024", timex.MustNewDate(2024, 3, 5), timex.TimeOfDay{}},
+		{"%A %B %d, %y", "Tuesday March 05, 24", timex.MustNewDate(2024, 3, 5), timex.TimeOfDay{}},
+		{"%Y %j", "2024 065", timex.MustNewDate(2024, 3, 5), timex.TimeOfDay{}},
+		{"%G-W%V-%u", "2025-W01-1", timex.MustNewDate(2024, 12, 30), timex.TimeOfDay{}},
+		{"%Y %G-W%V-%u", "2024 0000-W01-1", timex.MustDateFromWeek(timex.ISOWeekScheme, 0, 1, time.Monday), timex.TimeOfDay{}},
+		{"%Y %U %a", "2024 09 Tue", timex.MustNewDate(2024, 3, 5), timex.TimeOfDay{}},
+		{"%Y %W %u", "2024 10 2", timex.MustNewDate(2024, 3, 5), timex.TimeOfDay{}},
+		{"%Y %U %a", "2023 00 Sat", timex.MustNewDate(2022, 12, 31), timex.TimeOfDay{}},
+		{"%I:%M %p", "12:30 am", timex.Date{}, timex.MustNewTimeOfDay(0, 30, 0, 0)},
+		{"%I:%M %p", "9:05 PM", timex.Date{}, timex.MustNewTimeOfDay(21, 5, 0, 0)},
+		{"%H:%M:%S.%f", "12:34:56.123", timex.Date{}, timex.MustNewTimeOfDay(12, 34, 56, 123000000)},
+		{"%m/%d", "03/05", timex.MustNewDate(1, 3, 5), timex.TimeOfDay{}},
+		{"%d%%", "5%", timex.MustNewDate(1, 1, 5), timex.TimeOfDay{}},
+	}
+
+	for _, tt := range tests {
+		date, v, err := timex.ParseStrftime(tt.format, tt.value)
+		assert.NoError(t, err, tt.value)
+		assert.Equal(t, tt.date, date, tt.value)
+		assert.Equal(t, tt.t, v, tt.value)
+	}
+
+	t.Run("Errors", func(t *testing.T) {
+		tests := []struct {
+			format string
+			value  string
+			err    string
+		}{
+			{"%Y-%m-%d", "2024-03-05 12:00", `parsing "2024-03-05 12:00" as "%Y-%m-%d": extra text " 12:00"`},
+			{"%Y-%m-%d", "2024/03/05", `parsing "2024/03/05" as "%Y-%m-%d": cannot parse "/03/05" as "-"`},
+			{"%Y-%m-%d", "24-03-05", `parsing "24-03-05" as "%Y-%m-%d": cannot parse "24-03-05" as "%Y"`},
+			{"%d %b %Y", "05 Mrz 2024", `parsing "05 Mrz 2024" as "%d %b %Y": cannot parse "Mrz 2024" as "%b"`},
+			{"%H:%M", "12:", `parsing "12:" as "%H:%M": cannot parse "" as "%M"`},
+			{"%I %p", "13 PM", `parsing "13 PM" as "%I %p": cannot parse "13 PM" as "%I"`},
+			{"%H %M", "1230", `parsing "1230" as "%H %M": cannot parse "30" as " "`},
+			{"%Y-%m-%d", "2023-02-29", `parsing "2023-02-29" as "%Y-%m-%d": day is out of range [1,28]`},
+			{"%Y %j", "2023 366", `parsing "2023 366" as "%Y %j": day of year is out of range [1,365]`},
+			{"%H:%M", "24:00", `parsing "24:00" as "%H:%M": hour is out of range [0,23]`},
+			{"%Y %U", "2024 54", `parsing "2024 54" as "%Y %U": week is out of range [0,53]`},
+		}
+
+		for _, tt := range tests {
+			_, _, err := timex.ParseStrftime(tt.format, tt.value)
+			assert.EqualError(t, err, tt.err, tt.value)
+		}
+
+		_, _, err := timex.ParseStrftime("%Y", "2024x")
+		assert.ErrorIs(t, err, timex.ErrTrailingData)
+
+		ranges := []struct {
+			format     string
+			value      string
+			kind       timex.ErrorKind
+			offset     int
+			layoutElem string
+			valueElem  string
+		}{
+			{"%Y-%m-%d", "2024-02-30", timex.KindDayOutOfRange, 8, "%d", "30"},
+			{"%d %j %Y", "05 366 2023", timex.KindDayOutOfRange, 3, "%j", "366"},
+			{"%H:%M", "25:00", timex.KindHourOutOfRange, 0, "%H", "25"},
+			{"%Y %W", "2024 54", timex.KindWeekOutOfRange, 5, "%W", "54"},
+			{"%G-W%V", "2024-W53", timex.KindWeekOutOfRange, 6, "%V", "53"},
+		}
+
+		for _, tt := range ranges {
+			_, _, err := timex.ParseStrftime(tt.format, tt.value)
+			var e *timex.ParseError
+			if assert.ErrorAs(t, err, &e, tt.value) {
+				assert.Equal(t, tt.kind, e.Kind, tt.value)
+				assert.Equal(t, tt.offset, e.Offset, tt.value)
+				assert.Equal(t, tt.layoutElem, e.LayoutElem, tt.value)
+				assert.Equal(t, tt.valueElem, e.ValueElem, tt.value)
+				assert.ErrorIs(t, err, tt.kind.Err(), tt.value)
+			}
+		}
+	})
+
+	t.Run("RoundTrip", func(t *testing.T) {
+		format := "%Y-%m-%dT%H:%M:%S.%f %a %j %U %W %V %G %u"
+		date := timex.MustNewDate(2020, 1, 1)
+		v := timex.MustNewTimeOfDay(23, 59, 59, 999999000)
+		for n := 0; n < 800; n++ {
+			d, tt, err := timex.ParseStrftime(format, timex.FormatStrftime(format, date, v))
+			assert.NoError(t, err)
+			assert.Equal(t, date, d)
+			assert.Equal(t, v, tt)
+			date = date.AddDays(3)
+		}
+	})
+}
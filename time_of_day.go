@@ -1,7 +1,6 @@
 package timex
 
 import (
-	"errors"
 	"time"
 )
 
@@ -10,19 +9,25 @@ type TimeOfDay struct {
 	n int64
 }
 
+// EndOfDay returns the time of day 24:00:00, which is the end of a day in ISO 8601.
+// It is after any other time of day, and can be created by NewTimeOfDayLenient or ParseTimeOfDayLenient.
+func EndOfDay() TimeOfDay {
+	return TimeOfDay{n: nsecsEveryDay}
+}
+
 // NewTimeOfDay returns the time of day corresponding to hour, minute, second, and nanosecond.
 func NewTimeOfDay(hour, min, sec, nsec int) (TimeOfDay, error) {
 	if hour < 0 || hour > 23 {
-		return TimeOfDay{}, errors.New("hour is out of range [0,23]")
+		return TimeOfDay{}, rangeError(KindHourOutOfRange, hour, 0, 23)
 	}
 	if min < 0 || min > 59 {
-		return TimeOfDay{}, errors.New("minute is out of range [0,59]")
+		return TimeOfDay{}, rangeError(KindMinuteOutOfRange, min, 0, 59)
 	}
 	if sec < 0 || sec > 59 {
-		return TimeOfDay{}, errors.New("second is out of range [0,59]")
+		return TimeOfDay{}, rangeError(KindSecondOutOfRange, sec, 0, 59)
 	}
 	if nsec < 0 || nsec >= 1e9 {
-		return TimeOfDay{}, errors.New("nanosecond is out of range [0,1e9)")
+		return TimeOfDay{}, rangeError(KindNanosecondOutOfRange, nsec, 0, 1e9-1)
 	}
 
 	return TimeOfDay{n: timeToNanoseconds(hour, min, sec, nsec)}, nil
@@ -37,6 +42,27 @@ func MustNewTimeOfDay(hour, min, sec, nsec int) TimeOfDay {
 	return timeOfDay
 }
 
+// NewTimeOfDayLenient is like NewTimeOfDay but also accepts 24:00:00 as EndOfDay,
+// and a leap second 60 which is normalized to the start of the next minute.
+// The leap second must not have a fraction, which cannot be kept after the normalization.
+func NewTimeOfDayLenient(hour, min, sec, nsec int) (TimeOfDay, error) {
+	if hour == 24 && min == 0 && sec == 0 && nsec == 0 {
+		return EndOfDay(), nil
+	}
+	if sec != 60 {
+		return NewTimeOfDay(hour, min, sec, nsec)
+	}
+	if nsec != 0 {
+		return TimeOfDay{}, rangeError(KindNanosecondOutOfRange, nsec, 0, 0)
+	}
+
+	timeOfDay, err := NewTimeOfDay(hour, min, 59, 0)
+	if err != nil {
+		return TimeOfDay{}, err
+	}
+	return TimeOfDay{n: timeOfDay.n + nsecsEverySecond}, nil
+}
+
 // TimeOfDayFromTime returns the time of day specified by t.
 func TimeOfDayFromTime(t time.Time) TimeOfDay {
 	hour, min, sec := t.Clock()
@@ -109,6 +135,49 @@ func (t TimeOfDay) AddDuration(d time.Duration) (int, TimeOfDay) {
 	return int(day), TimeOfDay{n: n}
 }
 
+// Truncate returns the result of rounding t down to a multiple of d since midnight.
+// If d <= 0, Truncate returns t unchanged.
+func (t TimeOfDay) Truncate(d time.Duration) TimeOfDay {
+	if d <= 0 {
+		return t
+	}
+	return TimeOfDay{n: t.n - t.n%int64(d)}
+}
+
+// Round returns the exceeded days and the result of rounding t to the nearest multiple of d since midnight.
+// The rounding behavior for halfway values is to round up.
+// If d <= 0, Round returns t unchanged.
+func (t TimeOfDay) Round(d time.Duration) (int, TimeOfDay) {
+	if d <= 0 {
+		return 0, t
+	}
+
+	r := t.n % int64(d)
+	n := t.n - r
+	if r >= int64(d)-r {
+		n += int64(d)
+	}
+
+	day, n := norm0(0, n, nsecsEveryDay)
+	return int(day), TimeOfDay{n: n}
+}
+
+// Ceil returns the exceeded days and the result of rounding t up to a multiple of d since midnight.
+// If d <= 0, Ceil returns t unchanged.
+func (t TimeOfDay) Ceil(d time.Duration) (int, TimeOfDay) {
+	if d <= 0 {
+		return 0, t
+	}
+
+	n := t.n
+	if r := n % int64(d); r > 0 {
+		n += int64(d) - r
+	}
+
+	day, n := norm0(0, n, nsecsEveryDay)
+	return int(day), TimeOfDay{n: n}
+}
+
 // Sub returns the duration t-tt.
 func (t TimeOfDay) Sub(tt TimeOfDay) time.Duration {
 	return time.Duration(t.n - tt.n)
@@ -119,6 +188,11 @@ func (t TimeOfDay) IsZero() bool {
 	return t.n == 0
 }
 
+// IsEndOfDay reports whether the time of day t is EndOfDay, 24:00:00.
+func (t TimeOfDay) IsEndOfDay() bool {
+	return t.n == nsecsEveryDay
+}
+
 // Before reports whether the time of day t is before tt.
 func (t TimeOfDay) Before(tt TimeOfDay) bool {
 	return t.n < tt.n
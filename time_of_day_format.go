@@ -13,13 +13,16 @@ const (
 	tokenMinuteTwoDigit
 	tokenSecond
 	tokenSecondTwoDigit
+	tokenFraction
 )
 
 const (
 	RFC3339Time = "HH:mm:ss"
 )
 
-func nextTimeToken(layout string) (prefix string, token int, suffix string) {
+// nextTimeToken returns the next token of the layout after the token prev.
+// The fraction token must follow a second token directly or after a separator, otherwise S is a literal.
+func nextTimeToken(layout string, prev int) (prefix string, token int, suffix string) {
 	for i := 0; i < len(layout); i++ {
 		switch layout[i] {
 		case 'a':
@@ -47,6 +50,15 @@ func nextTimeToken(layout string) (prefix string, token int, suffix string) {
 			if len(layout) >= i+1 && layout[i:i+1] == "m" {
 				return layout[:i], tokenMinute, layout[i+1:]
 			}
+		case 'S': // S, SS, ..., SSSSSSSSS
+			if prev != tokenSecond && prev != tokenSecondTwoDigit || i > 1 || i == 1 && !isSeparator(layout[0]) {
+				continue
+			}
+			j := i + 1
+			for j < len(layout) && j-i < 9 && layout[j] == 'S' {
+				j++
+			}
+			return layout[:i], tokenFraction, layout[j:]
 		case 's': // s, ss
 			if len(layout) >= i+2 && layout[i:i+2] == "ss" {
 				return layout[:i], tokenSecondTwoDigit, layout[i+2:]
@@ -59,6 +71,20 @@ func nextTimeToken(layout string) (prefix string, token int, suffix string) {
 	return layout, 0, ""
 }
 
+// hasFractionToken reports whether the layout contains a fraction token.
+func hasFractionToken(layout string) bool {
+	var token int
+	for {
+		_, token, layout = nextTimeToken(layout, token)
+		switch token {
+		case 0:
+			return false
+		case tokenFraction:
+			return true
+		}
+	}
+}
+
 func parseStrictRFC3339Time(b []byte) (TimeOfDay, error) {
 	if len(b) < 6 {
 		return TimeOfDay{}, &ParseError{Layout: RFC3339Time, Value: string(b)}
@@ -85,30 +111,53 @@ func parseStrictRFC3339Time(b []byte) (TimeOfDay, error) {
 	if !ok {
 		return TimeOfDay{}, &ParseError{Layout: RFC3339Time, Value: string(b)}
 	}
+	if hour == 24 && min == 0 && sec == 0 && nsec == 0 {
+		return EndOfDay(), nil
+	}
 
 	return NewTimeOfDay(hour, min, sec, nsec)
 }
 
 // ParseTimeOfDay parses a formatted string and returns the time of day it represents.
 //
-//	a   am/pm  ante meridiem or post meridiem
-//	A   AM/PM  ante meridiem or post meridiem
-//	H    0-23  Two-digit hour, 24-hour clock
-//	HH  00-23  Hour, 24-hour clock
-//	h    1-12  Two-digit hour, 12-hour clock
-//	hh  01-12  Hour, 12-hour clock
-//	m    0-59  Minute
-//	mm  00-59  Minute, 2-digits
-//	s    0-59  Second, including fraction
-//	ss  00-59  Second, 2-digits, including fraction
+//	a     am/pm    ante meridiem or post meridiem
+//	A     AM/PM    ante meridiem or post meridiem
+//	H      0-23    Two-digit hour, 24-hour clock
+//	HH    00-23    Hour, 24-hour clock
+//	h      1-12    Two-digit hour, 12-hour clock
+//	hh    01-12    Hour, 12-hour clock
+//	m      0-59    Minute
+//	mm    00-59    Minute, 2-digits
+//	s      0-59    Second, including fraction
+//	ss    00-59    Second, 2-digits, including fraction
+//	SSS  000-999   Fraction of second, the number of S is the digits, up to 9
+//
+// The fraction token follows a second token directly or after a separator, such as ss.SSS, otherwise S is a literal.
+// If the layout contains a fraction token, the second tokens do not include fraction.
 func ParseTimeOfDay(layout, value string) (TimeOfDay, error) {
+	return parseTimeOfDay(layout, value, false, ParseOptions{})
+}
+
+// ParseTimeOfDayLenient is like ParseTimeOfDay but also accepts 24:00:00 as EndOfDay,
+// and a leap second 60 which is normalized to the start of the next minute.
+func ParseTimeOfDayLenient(layout, value string) (TimeOfDay, error) {
+	return parseTimeOfDay(layout, value, true, ParseOptions{})
+}
+
+// parseTimeOfDay parses the time of day with the options, endOfDay reports whether 24:00:00 and leap seconds are accepted.
+func parseTimeOfDay(layout, value string, endOfDay bool, o ParseOptions) (TimeOfDay, error) {
 	var hour, min, sec, nsec int
 	var amSet, pmSet bool
 
+	fixedFraction := hasFractionToken(layout)
+
 	originLayout, originValue := layout, value
 	var layoutElem, valueElem string
+	var token int
+	var spans elemSpans
 	for {
-		prefix, token, suffix := nextTimeToken(layout)
+		var prefix, suffix string
+		prefix, token, suffix = nextTimeToken(layout, token)
 		if token == 0 {
 			break
 		}
@@ -116,13 +165,14 @@ func ParseTimeOfDay(layout, value string) (TimeOfDay, error) {
 		layoutElem = layout[len(prefix) : len(layout)-len(suffix)]
 
 		layout = suffix
-		if len(value) < len(prefix) {
-			return TimeOfDay{}, &ParseError{Layout: originLayout, Value: originValue, LayoutElem: layoutElem, ValueElem: valueElem}
+		after, matched := o.cutLiteral(value, prefix)
+		switch {
+		case !matched && len(value) < len(prefix):
+			return TimeOfDay{}, &ParseError{Layout: originLayout, Value: originValue, LayoutElem: layoutElem, ValueElem: valueElem, Offset: len(originValue) - len(valueElem)}
+		case !matched:
+			return TimeOfDay{}, &ParseError{Layout: originLayout, Value: originValue, LayoutElem: prefix, ValueElem: value, Offset: len(originValue) - len(value)}
 		}
-		if value[:len(prefix)] != prefix {
-			return TimeOfDay{}, &ParseError{Layout: originLayout, Value: originValue, LayoutElem: prefix, ValueElem: value}
-		}
-		value = value[len(prefix):]
+		value = after
 
 		valueElem = value
 
@@ -148,21 +198,51 @@ func ParseTimeOfDay(layout, value string) (TimeOfDay, error) {
 				pmSet = true
 			}
 		case token24Hour, token12Hour:
-			hour, value, ok = atoi(value, 1, 2)
+			hour, value, ok = o.atoiUnpadded(value, 2)
 		case token24HourTwoDigit, token12HourTwoDigit:
-			hour, value, ok = atoi(value, 2, 2)
+			hour, value, ok = atoi(value, o.padded(2), 2)
 		case tokenMinute:
-			min, value, ok = atoi(value, 1, 2)
+			min, value, ok = o.atoiUnpadded(value, 2)
 		case tokenMinuteTwoDigit:
-			min, value, ok = atoi(value, 2, 2)
+			min, value, ok = atoi(value, o.padded(2), 2)
 		case tokenSecond:
-			sec, nsec, value, ok = atof(value, 1, 2, 9)
+			if fixedFraction {
+				sec, value, ok = o.atoiUnpadded(value, 2)
+			} else {
+				sec, nsec, value, ok = atof(value, 1, 2, 9)
+				ok = ok && !o.leadingZero(valueElem, value)
+			}
 		case tokenSecondTwoDigit:
-			sec, nsec, value, ok = atof(value, 2, 2, 9)
+			if fixedFraction {
+				sec, value, ok = atoi(value, o.padded(2), 2)
+			} else {
+				sec, nsec, value, ok = atof(value, o.padded(2), 2, 9)
+			}
+		case tokenFraction:
+			digits := len(layoutElem)
+			if len(value) > 0 && isDigit(value[0]) {
+				nsec, value, ok = atoi(value, digits, digits)
+				nsec *= pow10(9 - digits)
+			}
 		}
 
 		if !ok {
-			return TimeOfDay{}, &ParseError{Layout: originLayout, Value: originValue, LayoutElem: layoutElem, ValueElem: valueElem}
+			return TimeOfDay{}, &ParseError{Layout: originLayout, Value: originValue, LayoutElem: layoutElem, ValueElem: valueElem, Offset: len(originValue) - len(valueElem)}
+		}
+
+		span := elemSpan{layoutElem: layoutElem, valueElem: valueElem[:len(valueElem)-len(value)], offset: len(originValue) - len(valueElem)}
+		switch token {
+		case token24Hour, token12Hour, token24HourTwoDigit, token12HourTwoDigit:
+			spans[KindHourOutOfRange] = span
+		case tokenMinute, tokenMinuteTwoDigit:
+			spans[KindMinuteOutOfRange] = span
+		case tokenSecond, tokenSecondTwoDigit:
+			spans[KindSecondOutOfRange] = span
+			if !fixedFraction {
+				spans[KindNanosecondOutOfRange] = span
+			}
+		case tokenFraction:
+			spans[KindNanosecondOutOfRange] = span
 		}
 	}
 
@@ -172,7 +252,17 @@ func ParseTimeOfDay(layout, value string) (TimeOfDay, error) {
 		hour += 12
 	}
 
-	return NewTimeOfDay(hour, min, sec, nsec)
+	if o.Mode == ParseStrict {
+		if err := checkTrailing(originLayout, originValue, value, layout); err != nil {
+			return TimeOfDay{}, err
+		}
+	}
+	newTimeOfDay := NewTimeOfDay
+	if endOfDay || o.Mode == ParseLenient {
+		newTimeOfDay = NewTimeOfDayLenient
+	}
+	timeOfDay, err := newTimeOfDay(hour, min, sec, nsec)
+	return timeOfDay, spans.parseError(originLayout, originValue, err)
 }
 
 func (t TimeOfDay) appendRFC3339(b []byte) []byte {
@@ -188,7 +278,11 @@ func (t TimeOfDay) appendRFC3339(b []byte) []byte {
 }
 
 // hour12 returns the hour of 12-hour clock from 24-hour clock.
+// The end of day 24:00 has no hour in 12-hour clock, so it is kept as 24 to differ from the midnight 12:00 am.
 func hour12(hour int) int {
+	if hour == 24 {
+		return hour
+	}
 	hour12 := hour % 12
 	if hour12 == 0 {
 		hour12 = 12
@@ -197,6 +291,7 @@ func hour12(hour int) int {
 }
 
 // midday returns the string of am/pm from 24-hour clock.
+// The end of day 24:00 is the end of post meridiem.
 func midday(hour int, am, pm string) string {
 	if hour < 12 {
 		return am
@@ -208,13 +303,18 @@ func (t TimeOfDay) format(layout string) string {
 	hour, min, sec, nsec := nanosecondsToTime(t.n)
 	bytes := make([]byte, 0, len(layout)+10)
 
+	fixedFraction := hasFractionToken(layout)
+
+	var token int
 	for {
-		prefix, token, suffix := nextTimeToken(layout)
+		var prefix, suffix string
+		prefix, token, suffix = nextTimeToken(layout, token)
 		bytes = append(bytes, prefix...)
 		if token == 0 {
 			break
 		}
 
+		layoutElem := layout[len(prefix) : len(layout)-len(suffix)]
 		layout = suffix
 
 		switch token {
@@ -236,10 +336,17 @@ func (t TimeOfDay) format(layout string) string {
 			bytes = appendInt(bytes, min, 2)
 		case tokenSecond:
 			bytes = appendInt(bytes, sec, 0)
-			bytes = appendFraction(bytes, nsec, 9)
+			if !fixedFraction {
+				bytes = appendFraction(bytes, nsec, 9)
+			}
 		case tokenSecondTwoDigit:
 			bytes = appendInt(bytes, sec, 2)
-			bytes = appendFraction(bytes, nsec, 9)
+			if !fixedFraction {
+				bytes = appendFraction(bytes, nsec, 9)
+			}
+		case tokenFraction:
+			digits := len(layoutElem)
+			bytes = appendInt(bytes, nsec/pow10(9-digits), digits)
 		}
 	}
 
@@ -248,16 +355,21 @@ func (t TimeOfDay) format(layout string) string {
 
 // Format returns a textual representation of the time of day.
 //
-//	a   am/pm  ante meridiem or post meridiem
-//	A   AM/PM  ante meridiem or post meridiem
-//	H    0-23  Two-digit hour, 24-hour clock
-//	HH  00-23  Hour, 24-hour clock
-//	h    1-12  Two-digit hour, 12-hour clock
-//	hh  01-12  Hour, 12-hour clock
-//	m    0-59  Minute
-//	mm  00-59  Minute, 2-digits
-//	s    0-59  Second
-//	ss  00-59  Second, 2-digits
+//	a     am/pm    ante meridiem or post meridiem
+//	A     AM/PM    ante meridiem or post meridiem
+//	H      0-23    Two-digit hour, 24-hour clock
+//	HH    00-23    Hour, 24-hour clock
+//	h      1-12    Two-digit hour, 12-hour clock
+//	hh    01-12    Hour, 12-hour clock
+//	m      0-59    Minute
+//	mm    00-59    Minute, 2-digits
+//	s      0-59    Second, including fraction without trailing zeros
+//	ss    00-59    Second, 2-digits, including fraction without trailing zeros
+//	SSS  000-999   Fraction of second, the number of S is the digits, up to 9
+//
+// The fraction token follows a second token directly or after a separator, such as ss.SSS, otherwise S is a literal.
+// If the layout contains a fraction token, the second tokens do not include fraction.
+// EndOfDay is formatted as 24:00 pm in 12-hour clock, which differs from the midnight 12:00 am.
 func (t TimeOfDay) Format(layout string) string {
 	switch layout {
 	case RFC3339Time:
@@ -276,6 +388,10 @@ func (t TimeOfDay) String() string {
 
 // GoString returns the Go syntax of the time of day.
 func (t TimeOfDay) GoString() string {
+	if t.IsEndOfDay() {
+		return "timex.EndOfDay()"
+	}
+
 	hour, min, sec, nsec := nanosecondsToTime(t.n)
 
 	bytes := make([]byte, 0, 32)
@@ -308,7 +424,8 @@ func (t TimeOfDay) MarshalJSON() ([]byte, error) {
 }
 
 // UnmarshalJSON implements the json.Unmarshaler interface.
-// The time of day is expected to be a quoted string in RFC 3339 format.
+// The time of day is expected to be a quoted string in RFC 3339 format,
+// and 24:00:00 written by MarshalJSON for EndOfDay is accepted as EndOfDay.
 func (t *TimeOfDay) UnmarshalJSON(data []byte) error {
 	if string(data) == "null" {
 		return nil
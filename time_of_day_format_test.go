@@ -136,6 +136,60 @@ func TestParseTimeOfDay(t *testing.T) {
 	})
 }
 
+func TestTimeOfDayFormatFraction(t *testing.T) {
+	tests := []struct {
+		layout string
+		str    string
+		nsec   int
+	}{
+		{"HH:mm:ss.S", "15:04:05.1", 1e8},
+		{"HH:mm:ss.SSS", "15:04:05.120", 120e6},
+		{"HH:mm:ss,SSS", "15:04:05,120", 120e6},
+		{"HH:mm:ss.SSSSSS", "15:04:05.120034", 120034e3},
+		{"HH:mm:ss.SSSSSSSSS", "15:04:05.120034500", 120034500},
+		{"HHmmssSSS", "150405120", 120e6},
+	}
+
+	timeOfDay := timex.MustNewTimeOfDay(15, 4, 5, 120034500)
+	for _, tt := range tests {
+		assert.Equal(t, tt.str, timeOfDay.Format(tt.layout))
+
+		parsed, err := timex.ParseTimeOfDay(tt.layout, tt.str)
+		assert.NoError(t, err)
+		assert.Equal(t, timex.MustNewTimeOfDay(15, 4, 5, tt.nsec), parsed)
+	}
+
+	assert.Equal(t, "00:00:00.000", timex.TimeOfDay{}.Format("HH:mm:ss.SSS"))
+
+	t.Run("Literal", func(t *testing.T) {
+		timeOfDay := timex.MustNewTimeOfDay(1, 2, 3, 5e8)
+		assert.Equal(t, "01:02:03.5 TS", timeOfDay.Format("HH:mm:ss TS"))
+		assert.Equal(t, "S 01:02:03.5", timeOfDay.Format("S HH:mm:ss"))
+		assert.Equal(t, "01:02:03.5", timeOfDay.Format("HH:mm:ss.S"))
+
+		v, err := timex.ParseTimeOfDay("HH:mm:ss TS", "01:02:03.5 TS")
+		assert.NoError(t, err)
+		assert.Equal(t, timeOfDay, v)
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		errTests := []struct {
+			layout    string
+			value     string
+			errString string
+		}{
+			{"HH:mm:ss.SSS", "15:04:05.12", `parsing "15:04:05.12" as "HH:mm:ss.SSS": cannot parse "12" as "SSS"`},
+			{"HH:mm:ss.SSS", "15:04:05.-12", `parsing "15:04:05.-12" as "HH:mm:ss.SSS": cannot parse "-12" as "SSS"`},
+			{"HH:mm:ss.SSS", "15:04:05", `parsing "15:04:05" as "HH:mm:ss.SSS": cannot parse "05" as "SSS"`},
+		}
+
+		for _, tt := range errTests {
+			_, err := timex.ParseTimeOfDay(tt.layout, tt.value)
+			assert.EqualError(t, err, tt.errString)
+		}
+	})
+}
+
 func TestParseTimeOfDayErrors(t *testing.T) {
 	tests := []struct {
 		layout    string
@@ -157,6 +211,51 @@ func TestParseTimeOfDayErrors(t *testing.T) {
 	}
 }
 
+func TestParseTimeOfDayLenient(t *testing.T) {
+	tests := []struct {
+		layout    string
+		value     string
+		timeOfDay timex.TimeOfDay
+		strict    bool
+	}{
+		{timex.RFC3339Time, "15:04:05", timex.MustNewTimeOfDay(15, 4, 5, 0), true},
+		{timex.RFC3339Time, "24:00:00", timex.EndOfDay(), false},
+		{"HH:mm", "24:00", timex.EndOfDay(), false},
+		{timex.RFC3339Time, "23:59:60", timex.EndOfDay(), false},
+		{timex.RFC3339Time, "11:59:60", timex.MustNewTimeOfDay(12, 0, 0, 0), false},
+		{"hh:mm A", "24:00 PM", timex.EndOfDay(), false},
+	}
+
+	for _, tt := range tests {
+		timeOfDay, err := timex.ParseTimeOfDayLenient(tt.layout, tt.value)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.timeOfDay, timeOfDay)
+
+		_, err = timex.ParseTimeOfDay(tt.layout, tt.value)
+		assert.Equal(t, tt.strict, err == nil)
+	}
+
+	t.Run("Format", func(t *testing.T) {
+		assert.Equal(t, "24:00:00", timex.EndOfDay().String())
+		assert.Equal(t, "24:00pm", timex.EndOfDay().Format("hh:mma"))
+		assert.Equal(t, "12:00am", timex.TimeOfDay{}.Format("hh:mma"))
+		assert.Equal(t, "timex.EndOfDay()", timex.EndOfDay().GoString())
+
+		timeOfDay, err := timex.ParseTimeOfDayLenient(timex.RFC3339Time, timex.EndOfDay().String())
+		assert.NoError(t, err)
+		assert.Equal(t, timex.EndOfDay(), timeOfDay)
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		_, err := timex.ParseTimeOfDayLenient(timex.RFC3339Time, "24:00:01")
+		assert.EqualError(t, err, `parsing "24:00:01" as "HH:mm:ss": hour is out of range [0,23]`)
+		_, err = timex.ParseTimeOfDayLenient(timex.RFC3339Time, "23:59:61")
+		assert.EqualError(t, err, `parsing "23:59:61" as "HH:mm:ss": second is out of range [0,59]`)
+		_, err = timex.ParseTimeOfDayLenient(timex.RFC3339Time, "12:30:60.5")
+		assert.EqualError(t, err, `parsing "12:30:60.5" as "HH:mm:ss": nanosecond is out of range [0,0]`)
+	})
+}
+
 func FuzzParseTimeOfDay(f *testing.F) {
 	f.Add("HH:mm:ss", "15:04:05")
 	f.Add(" HH:mm:ss", "")
@@ -225,6 +324,20 @@ func TestTimeOfDayMarshalJSON(t *testing.T) {
 		assert.Equal(t, t1, t2)
 	}
 
+	t.Run("EndOfDay", func(t *testing.T) {
+		bytes, err := timex.EndOfDay().MarshalJSON()
+		assert.NoError(t, err)
+		assert.Equal(t, `"24:00:00"`, string(bytes))
+
+		var timeOfDay timex.TimeOfDay
+		err = timeOfDay.UnmarshalJSON(bytes)
+		assert.NoError(t, err)
+		assert.Equal(t, timex.EndOfDay(), timeOfDay)
+
+		err = timeOfDay.UnmarshalJSON([]byte(`"24:00:01"`))
+		assert.EqualError(t, err, "hour is out of range [0,23]")
+	})
+
 	t.Run("Null", func(t *testing.T) {
 		var timeOfDay timex.TimeOfDay
 		err := timeOfDay.UnmarshalJSON([]byte("null"))
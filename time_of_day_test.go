@@ -220,6 +220,69 @@ func TestNewTimeOfDayErrors(t *testing.T) {
 	}
 }
 
+func TestNewTimeOfDayLenient(t *testing.T) {
+	tests := []struct {
+		hour, min, sec, nsec int
+		timeOfDay            timex.TimeOfDay
+	}{
+		{0, 0, 0, 0, timex.MustNewTimeOfDay(0, 0, 0, 0)},
+		{15, 4, 5, 6, timex.MustNewTimeOfDay(15, 4, 5, 6)},
+		{24, 0, 0, 0, timex.EndOfDay()},
+		{12, 0, 60, 0, timex.MustNewTimeOfDay(12, 1, 0, 0)},
+		{12, 59, 60, 0, timex.MustNewTimeOfDay(13, 0, 0, 0)},
+		{23, 59, 60, 0, timex.EndOfDay()},
+	}
+
+	for _, tt := range tests {
+		timeOfDay, err := timex.NewTimeOfDayLenient(tt.hour, tt.min, tt.sec, tt.nsec)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.timeOfDay, timeOfDay)
+	}
+
+	t.Run("Errors", func(t *testing.T) {
+		errTests := []struct {
+			hour, min, sec, nsec int
+			errString            string
+		}{
+			{24, 0, 0, 1, "hour is out of range [0,23]"},
+			{24, 1, 0, 0, "hour is out of range [0,23]"},
+			{25, 0, 0, 0, "hour is out of range [0,23]"},
+			{12, 0, 61, 0, "second is out of range [0,59]"},
+			{12, 60, 60, 0, "minute is out of range [0,59]"},
+			{12, 0, 60, 1e9, "nanosecond is out of range [0,0]"},
+			{12, 30, 60, 5e8, "nanosecond is out of range [0,0]"},
+			{23, 59, 60, 1, "nanosecond is out of range [0,0]"},
+		}
+
+		for _, tt := range errTests {
+			_, err := timex.NewTimeOfDayLenient(tt.hour, tt.min, tt.sec, tt.nsec)
+			assert.EqualError(t, err, tt.errString)
+		}
+	})
+}
+
+func TestEndOfDay(t *testing.T) {
+	hour, min, sec, nsec := timex.EndOfDay().Clock()
+	assert.Equal(t, 24, hour)
+	assert.Equal(t, 0, min)
+	assert.Equal(t, 0, sec)
+	assert.Equal(t, 0, nsec)
+
+	assert.True(t, timex.EndOfDay().IsEndOfDay())
+	assert.False(t, timex.EndOfDay().IsZero())
+	assert.False(t, timex.MustNewTimeOfDay(0, 0, 0, 0).IsEndOfDay())
+
+	last := timex.MustNewTimeOfDay(23, 59, 59, 1e9-1)
+	assert.True(t, last.Before(timex.EndOfDay()))
+	assert.True(t, timex.EndOfDay().After(last))
+	assert.Equal(t, time.Nanosecond, timex.EndOfDay().Sub(last))
+	assert.Equal(t, 24*time.Hour, timex.EndOfDay().Sub(timex.TimeOfDay{}))
+
+	days, timeOfDay := timex.EndOfDay().Add(0, 0, 0, 0)
+	assert.Equal(t, 1, days)
+	assert.True(t, timeOfDay.IsZero())
+}
+
 func TestTimeOfDayAdd(t *testing.T) {
 	tests := []struct {
 		hours, mins, secs, nsecs   int
@@ -298,6 +361,67 @@ func TestTimeOfDayAdd(t *testing.T) {
 	})
 }
 
+func TestTimeOfDayRound(t *testing.T) {
+	tests := []struct {
+		t        timex.TimeOfDay
+		d        time.Duration
+		truncate timex.TimeOfDay
+		round    timex.TimeOfDay
+		ceil     timex.TimeOfDay
+		roundDay int
+		ceilDay  int
+	}{
+		{
+			timex.MustNewTimeOfDay(10, 7, 0, 0), 15 * time.Minute,
+			timex.MustNewTimeOfDay(10, 0, 0, 0), timex.MustNewTimeOfDay(10, 0, 0, 0), timex.MustNewTimeOfDay(10, 15, 0, 0), 0, 0,
+		},
+		{
+			timex.MustNewTimeOfDay(10, 7, 30, 0), 15 * time.Minute,
+			timex.MustNewTimeOfDay(10, 0, 0, 0), timex.MustNewTimeOfDay(10, 15, 0, 0), timex.MustNewTimeOfDay(10, 15, 0, 0), 0, 0,
+		},
+		{
+			timex.MustNewTimeOfDay(10, 15, 0, 0), 15 * time.Minute,
+			timex.MustNewTimeOfDay(10, 15, 0, 0), timex.MustNewTimeOfDay(10, 15, 0, 0), timex.MustNewTimeOfDay(10, 15, 0, 0), 0, 0,
+		},
+		{
+			timex.MustNewTimeOfDay(23, 50, 0, 0), 15 * time.Minute,
+			timex.MustNewTimeOfDay(23, 45, 0, 0), timex.MustNewTimeOfDay(23, 45, 0, 0), timex.MustNewTimeOfDay(0, 0, 0, 0), 0, 1,
+		},
+		{
+			timex.MustNewTimeOfDay(23, 59, 59, 999500000), time.Millisecond,
+			timex.MustNewTimeOfDay(23, 59, 59, 999000000), timex.MustNewTimeOfDay(0, 0, 0, 0), timex.MustNewTimeOfDay(0, 0, 0, 0), 1, 1,
+		},
+		{
+			timex.MustNewTimeOfDay(15, 4, 5, 123456789), time.Millisecond,
+			timex.MustNewTimeOfDay(15, 4, 5, 123000000), timex.MustNewTimeOfDay(15, 4, 5, 123000000), timex.MustNewTimeOfDay(15, 4, 5, 124000000), 0, 0,
+		},
+		{
+			timex.MustNewTimeOfDay(15, 4, 5, 6), 0,
+			timex.MustNewTimeOfDay(15, 4, 5, 6), timex.MustNewTimeOfDay(15, 4, 5, 6), timex.MustNewTimeOfDay(15, 4, 5, 6), 0, 0,
+		},
+		{
+			timex.MustNewTimeOfDay(15, 4, 5, 6), -time.Hour,
+			timex.MustNewTimeOfDay(15, 4, 5, 6), timex.MustNewTimeOfDay(15, 4, 5, 6), timex.MustNewTimeOfDay(15, 4, 5, 6), 0, 0,
+		},
+		{
+			timex.MustNewTimeOfDay(15, 4, 5, 6), 48 * time.Hour,
+			timex.MustNewTimeOfDay(0, 0, 0, 0), timex.MustNewTimeOfDay(0, 0, 0, 0), timex.MustNewTimeOfDay(0, 0, 0, 0), 0, 2,
+		},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.truncate, tt.t.Truncate(tt.d))
+
+		days, round := tt.t.Round(tt.d)
+		assert.Equal(t, tt.roundDay, days)
+		assert.Equal(t, tt.round, round)
+
+		days, ceil := tt.t.Ceil(tt.d)
+		assert.Equal(t, tt.ceilDay, days)
+		assert.Equal(t, tt.ceil, ceil)
+	}
+}
+
 func TestTimeOfDaySub(t *testing.T) {
 	tests := []struct {
 		t1, t2 timex.TimeOfDay
@@ -6,6 +6,33 @@ func fromDigit(c byte) int { return int(c - '0') }
 
 func toDigit(n int) byte { return byte(n) + '0' }
 
+// isSeparator reports whether c is an ASCII character other than letters and digits, such as '-' and ' '.
+func isSeparator(c byte) bool {
+	lower := c | ('a' - 'A')
+	return c < 0x80 && !isDigit(c) && (lower < 'a' || lower > 'z')
+}
+
+// pow10 returns 10**n for n in [0, 9].
+func pow10(n int) int {
+	p := 1
+	for ; n > 0; n-- {
+		p *= 10
+	}
+	return p
+}
+
+// floorDiv returns the quotient a/b rounded down.
+func floorDiv(a, b int) int {
+	q, _ := norm1(0, a+1, b)
+	return q
+}
+
+// floorMod returns the remainder of floorDiv(a, b), which has the same sign as b.
+func floorMod(a, b int) int {
+	_, r := norm1(0, a+1, b)
+	return r - 1
+}
+
 // match reports whether s1 and s2 match ignoring case.
 // It is assumed s1 and s2 are the same length.
 func match(s1, s2 string) bool {
@@ -25,14 +52,18 @@ func match(s1, s2 string) bool {
 }
 
 // searchName reports whether the prefix of value exist in names.
-// It returns the index and left string.
+// It returns the index of the longest matched name and left string.
 func searchName(names []string, value string) (int, string, bool) {
+	index := -1
 	for i, name := range names {
-		if len(value) >= len(name) && match(value[:len(name)], name) {
-			return i, value[len(name):], true
+		if len(value) >= len(name) && match(value[:len(name)], name) && (index < 0 || len(name) > len(names[index])) {
+			index = i
 		}
 	}
-	return -1, value, false
+	if index < 0 {
+		return -1, value, false
+	}
+	return index, value[len(names[index]):], true
 }
 
 // atoi converts a string to integer with minimum and maximum digit length.
@@ -87,3 +87,23 @@ func TestAppendFraction(t *testing.T) {
 		assert.Equal(t, tt.s, string(bytes))
 	}
 }
+
+func TestFloorDiv(t *testing.T) {
+	tests := []struct {
+		a, b int
+		div  int
+		mod  int
+	}{
+		{7, 3, 2, 1},
+		{6, 3, 2, 0},
+		{0, 3, 0, 0},
+		{-1, 3, -1, 2},
+		{-3, 3, -1, 0},
+		{-7, 3, -3, 2},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.div, floorDiv(tt.a, tt.b))
+		assert.Equal(t, tt.mod, floorMod(tt.a, tt.b))
+	}
+}
@@ -0,0 +1,152 @@
+package timex
+
+import (
+	"time"
+)
+
+// WeekScheme is a rule of week numbering.
+// Weeks start on the first day of week, and the first week of a week-based year is
+// the first week which has at least the minimal days in the calendar year.
+// The methods clamp MinDays into [1,7], see Validate.
+type WeekScheme struct {
+	FirstDay time.Weekday // FirstDay is the first day of week.
+	MinDays  int          // MinDays is the minimal days in the first week, in [1,7].
+}
+
+// isoWeekScheme is the week numbering of ISO 8601 used by the formats, which cannot be reassigned by callers.
+var isoWeekScheme = WeekScheme{FirstDay: time.Monday, MinDays: 4}
+
+var (
+	// ISOWeekScheme is the week numbering of ISO 8601, weeks start on monday and
+	// the first week contains the first thursday of the year.
+	// It is a copy, reassigning it does not change the ISO 8601 weeks of ParseDate, Date.Format and the strftime directives.
+	ISOWeekScheme = isoWeekScheme
+	// USWeekScheme is the week numbering in United States, weeks start on sunday and
+	// the first week contains January 1.
+	USWeekScheme = WeekScheme{FirstDay: time.Sunday, MinDays: 1}
+	// MiddleEastWeekScheme is the week numbering in most Middle East countries, weeks start on saturday and
+	// the first week contains January 1.
+	MiddleEastWeekScheme = WeekScheme{FirstDay: time.Saturday, MinDays: 1}
+	// BroadcastWeekScheme is the week numbering of the broadcast calendar, weeks start on monday and
+	// the first week contains January 1.
+	BroadcastWeekScheme = WeekScheme{FirstDay: time.Monday, MinDays: 1}
+)
+
+// Validate returns a *RangeError if a field of the week scheme is out of range.
+func (s WeekScheme) Validate() error {
+	switch {
+	case s.FirstDay < time.Sunday || s.FirstDay > time.Saturday:
+		return &RangeError{Kind: KindDayOutOfRange, Elem: "first day", Value: int(s.FirstDay), Min: 0, Max: 6}
+	case s.MinDays < 1 || s.MinDays > 7:
+		return &RangeError{Kind: KindDayOutOfRange, Elem: "minimal days", Value: s.MinDays, Min: 1, Max: 7}
+	}
+	return nil
+}
+
+// firstWeekStart returns the first day of the first week in the week-based year.
+func (s WeekScheme) firstWeekStart(year int) Date {
+	minDays := s.MinDays
+	switch {
+	case minDays < 1:
+		minDays = 1
+	case minDays > 7:
+		minDays = 7
+	}
+
+	jan1 := Date{ordinal: calendarToOrdinal(year, 1, 1)}
+	start := jan1.StartOfWeek(s.FirstDay)
+	if 7-jan1.Sub(start) < minDays {
+		start = start.AddDays(7)
+	}
+	return start
+}
+
+// Weeks returns the number of weeks in the week-based year.
+func (s WeekScheme) Weeks(year int) int {
+	return s.firstWeekStart(year+1).Sub(s.firstWeekStart(year)) / 7
+}
+
+// Week returns the week-based year and week number of the date d.
+func (s WeekScheme) Week(d Date) (year, week int) {
+	year = d.Year()
+	start := s.firstWeekStart(year + 1)
+	if d.Before(start) {
+		start = s.firstWeekStart(year)
+		if d.Before(start) {
+			year--
+			start = s.firstWeekStart(year)
+		}
+	} else {
+		year++
+	}
+	return year, d.Sub(start)/7 + 1
+}
+
+// DayOfWeek returns the day of week of the date d, beginning at 1 on the first day of week.
+func (s WeekScheme) DayOfWeek(d Date) int {
+	return (int(d.Weekday())-int(s.FirstDay)%7+7)%7 + 1
+}
+
+// Date returns the date corresponding to the week-based year, week number and weekday.
+func (s WeekScheme) Date(year, week int, weekday time.Weekday) (Date, error) {
+	if weeks := s.Weeks(year); week < 1 || week > weeks {
+		return Date{}, rangeError(KindWeekOutOfRange, week, 1, weeks)
+	}
+
+	start := s.firstWeekStart(year).AddDays((week - 1) * 7)
+	return start.NextOrSame(weekday), nil
+}
+
+// Parse parses a formatted string and returns the date it represents.
+// The layout uses the tokens of ParseDate, and the week tokens are in the week scheme.
+//
+//	IYYY   2001             Four-digit week-based year
+//	IW    01-53             Week, 2-digits
+//	ID      1-7             Day of week, beginning at 1 on the first day of week
+//
+// If any of the week tokens is in the layout, the date is built from the week,
+// the week-based year defaults to the year and the day of week defaults to 1.
+func (s WeekScheme) Parse(layout, value string) (Date, error) {
+	f, err := parseDate(layout, value, elemYear|elemMonth|elemDay|elemWeek, gregorianMonthNames)
+	if err != nil {
+		return Date{}, err
+	}
+	return f.date(s)
+}
+
+// Format returns a textual representation of the date d.
+// The layout uses the tokens of Date.Format, and the week tokens are in the week scheme.
+//
+//	IYYY   2001             Four-digit week-based year
+//	IW    01-53             Week, 2-digits
+//	ID      1-7             Day of week, beginning at 1 on the first day of week
+func (s WeekScheme) Format(d Date, layout string) string {
+	bytes := make([]byte, 0, len(layout)+10)
+	bytes = appendDate(bytes, layout, elemYear|elemMonth|elemDay|elemWeek, gregorianMonthNames, d.fields(s))
+	return string(bytes)
+}
+
+// BroadcastMonthOf returns the broadcast month of the date d.
+// A broadcast month ends on the last sunday of the calendar month, and starts on the monday after the previous one.
+func BroadcastMonthOf(d Date) YearMonth {
+	return YearMonthOf(d.StartOfWeek(time.Monday).AddDays(6))
+}
+
+// Week returns the week-based year and week number specified by d in the week scheme.
+func (d Date) Week(scheme WeekScheme) (year, week int) {
+	return scheme.Week(d)
+}
+
+// DateFromWeek returns the date corresponding to the week-based year, week number and weekday in the week scheme.
+func DateFromWeek(scheme WeekScheme, year, week int, weekday time.Weekday) (Date, error) {
+	return scheme.Date(year, week, weekday)
+}
+
+// MustDateFromWeek is like DateFromWeek but panics if the date cannot be created.
+func MustDateFromWeek(scheme WeekScheme, year, week int, weekday time.Weekday) Date {
+	date, err := DateFromWeek(scheme, year, week, weekday)
+	if err != nil {
+		panic(`timex: DateFromWeek: ` + err.Error())
+	}
+	return date
+}
@@ -0,0 +1,208 @@
+package timex_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/invzhi/timex"
+)
+
+func TestWeekScheme(t *testing.T) {
+	tests := []struct {
+		scheme     timex.WeekScheme
+		year, week int
+		weeks      int
+		start      timex.Date
+	}{
+		{timex.ISOWeekScheme, 2024, 1, 52, timex.MustNewDate(2024, 1, 1)},
+		{timex.ISOWeekScheme, 2020, 53, 53, timex.MustNewDate(2020, 12, 28)},
+		{timex.USWeekScheme, 2023, 1, 52, timex.MustNewDate(2023, 1, 1)},
+		{timex.USWeekScheme, 2024, 1, 52, timex.MustNewDate(2023, 12, 31)},
+		{timex.USWeekScheme, 2025, 1, 52, timex.MustNewDate(2024, 12, 29)},
+		{timex.MiddleEastWeekScheme, 2024, 1, 52, timex.MustNewDate(2023, 12, 30)},
+		{timex.MiddleEastWeekScheme, 2022, 1, 52, timex.MustNewDate(2022, 1, 1)},
+		{timex.BroadcastWeekScheme, 2023, 1, 53, timex.MustNewDate(2022, 12, 26)},
+		{timex.BroadcastWeekScheme, 2023, 53, 53, timex.MustNewDate(2023, 12, 25)},
+		{timex.BroadcastWeekScheme, 2024, 1, 52, timex.MustNewDate(2024, 1, 1)},
+		{timex.BroadcastWeekScheme, 2025, 1, 52, timex.MustNewDate(2024, 12, 30)},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.weeks, tt.scheme.Weeks(tt.year))
+		assert.Equal(t, tt.scheme.FirstDay, tt.start.Weekday())
+
+		for n := 0; n < 7; n++ {
+			date := tt.start.AddDays(n)
+
+			year, week := date.Week(tt.scheme)
+			assert.Equal(t, tt.year, year)
+			assert.Equal(t, tt.week, week)
+			assert.Equal(t, n+1, tt.scheme.DayOfWeek(date))
+
+			assert.Equal(t, date, timex.MustDateFromWeek(tt.scheme, tt.year, tt.week, date.Weekday()))
+		}
+	}
+
+	t.Run("ISOWeek", func(t *testing.T) {
+		date := timex.MustNewDate(1999, 1, 1)
+		for n := 0; n < 3000; n++ {
+			year, week := date.ISOWeek()
+			assert.Equal(t, timex.MustNewYearWeek(year, week), timex.YearWeekOf(date))
+
+			y, w := date.Week(timex.ISOWeekScheme)
+			assert.Equal(t, year, y)
+			assert.Equal(t, week, w)
+			date = date.AddDays(1)
+		}
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		tests := []struct {
+			scheme     timex.WeekScheme
+			year, week int
+			err        string
+		}{
+			{timex.BroadcastWeekScheme, 2024, 53, "week is out of range [1,52]"},
+			{timex.BroadcastWeekScheme, 2023, 54, "week is out of range [1,53]"},
+			{timex.USWeekScheme, 2024, 0, "week is out of range [1,52]"},
+		}
+
+		for _, tt := range tests {
+			_, err := timex.DateFromWeek(tt.scheme, tt.year, tt.week, time.Monday)
+			assert.EqualError(t, err, tt.err)
+		}
+
+		assert.PanicsWithValue(t, "timex: DateFromWeek: week is out of range [1,52]", func() {
+			timex.MustDateFromWeek(timex.ISOWeekScheme, 2024, 53, time.Monday)
+		})
+	})
+}
+
+func TestBroadcastMonthOf(t *testing.T) {
+	tests := []struct {
+		date  timex.Date
+		month timex.YearMonth
+	}{
+		{timex.MustNewDate(2024, 1, 1), timex.MustNewYearMonth(2024, 1)},
+		{timex.MustNewDate(2024, 2, 26), timex.MustNewYearMonth(2024, 3)},
+		{timex.MustNewDate(2024, 3, 31), timex.MustNewYearMonth(2024, 3)},
+		{timex.MustNewDate(2024, 4, 1), timex.MustNewYearMonth(2024, 4)},
+		{timex.MustNewDate(2024, 12, 30), timex.MustNewYearMonth(2025, 1)},
+		{timex.MustNewDate(2022, 12, 26), timex.MustNewYearMonth(2023, 1)},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.month, timex.BroadcastMonthOf(tt.date))
+	}
+}
+
+func TestWeekSchemeValidate(t *testing.T) {
+	assert.NoError(t, timex.ISOWeekScheme.Validate())
+	assert.NoError(t, timex.USWeekScheme.Validate())
+
+	tests := []struct {
+		scheme    timex.WeekScheme
+		errString string
+	}{
+		{timex.WeekScheme{FirstDay: 7, MinDays: 4}, "first day is out of range [0,6]"},
+		{timex.WeekScheme{FirstDay: time.Monday}, "minimal days is out of range [1,7]"},
+		{timex.WeekScheme{FirstDay: time.Monday, MinDays: 8}, "minimal days is out of range [1,7]"},
+	}
+
+	for _, tt := range tests {
+		assert.EqualError(t, tt.scheme.Validate(), tt.errString)
+	}
+
+	// MinDays is clamped into [1,7].
+	date := timex.MustNewDate(2024, 1, 1)
+	year, week := timex.WeekScheme{FirstDay: time.Sunday}.Week(date)
+	assert.Equal(t, [2]int{2024, 1}, [2]int{year, week})
+	year, week = timex.WeekScheme{FirstDay: time.Sunday, MinDays: 9}.Week(date)
+	assert.Equal(t, [2]int{2023, 53}, [2]int{year, week})
+}
+
+func TestWeekSchemeFormat(t *testing.T) {
+	tests := []struct {
+		scheme timex.WeekScheme
+		layout string
+		date   timex.Date
+		value  string
+	}{
+		{timex.ISOWeekScheme, "IYYY-IW-ID", timex.MustNewDate(2024, 12, 30), "2025-01-1"},
+		{timex.ISOWeekScheme, "IYYY-IW-ID", timex.MustNewDate(2021, 1, 3), "2020-53-7"},
+		{timex.USWeekScheme, "IYYY-IW-ID", timex.MustNewDate(2024, 12, 29), "2025-01-1"},
+		{timex.USWeekScheme, "IYYY-IW-ID", timex.MustNewDate(2024, 12, 28), "2024-52-7"},
+		{timex.MiddleEastWeekScheme, "IYYY IW ID", timex.MustNewDate(2024, 1, 1), "2024 01 3"},
+		{timex.BroadcastWeekScheme, "YYYY-MM-DD IYYY-IW-ID", timex.MustNewDate(2023, 12, 31), "2023-12-31 2023-53-7"},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.value, tt.scheme.Format(tt.date, tt.layout))
+
+		date, err := tt.scheme.Parse(tt.layout, tt.value)
+		assert.NoError(t, err)
+		assert.Equal(t, tt.date, date)
+	}
+
+	t.Run("Date", func(t *testing.T) {
+		date := timex.MustNewDate(2024, 12, 30)
+		assert.Equal(t, "2025-W01-1", date.Format("IYYY-WIW-ID"))
+
+		d, err := timex.ParseDate("IYYY-WIW-ID", "2025-W01-1")
+		assert.NoError(t, err)
+		assert.Equal(t, date, d)
+
+		d, err = timex.ParseDate("YYYY IW", "2024 10")
+		assert.NoError(t, err)
+		assert.Equal(t, timex.MustNewDate(2024, 3, 4), d)
+
+		d, err = timex.ParseDate("YYYY IYYY-IW-ID", "2024 0000-01-1")
+		assert.NoError(t, err)
+		assert.Equal(t, timex.MustDateFromWeek(timex.ISOWeekScheme, 0, 1, time.Monday), d)
+	})
+
+	t.Run("Reassigned", func(t *testing.T) {
+		scheme := timex.ISOWeekScheme
+		defer func() { timex.ISOWeekScheme = scheme }()
+		timex.ISOWeekScheme = timex.USWeekScheme
+
+		date := timex.MustNewDate(2024, 12, 29)
+		assert.Equal(t, "2024-52-7", date.Format("IYYY-IW-ID"))
+		assert.Equal(t, "52 7", timex.FormatStrftime("%V %u", date, timex.TimeOfDay{}))
+
+		d, err := timex.ParseDate("IYYY-IW-ID", "2024-52-7")
+		assert.NoError(t, err)
+		assert.Equal(t, date, d)
+	})
+
+	t.Run("Literal", func(t *testing.T) {
+		date := timex.MustNewDate(2024, 12, 30)
+		assert.Equal(t, "Issue 2024-12, I", date.Format("Issue YYYY-MM, I"))
+		assert.Equal(t, "1 2025", date.Format("ID IYYY"))
+
+		d, err := timex.ParseDate("Issue YYYY-MM-DD, I", "Issue 2024-12-30, I")
+		assert.NoError(t, err)
+		assert.Equal(t, date, d)
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		tests := []struct {
+			scheme timex.WeekScheme
+			layout string
+			value  string
+			err    string
+		}{
+			{timex.ISOWeekScheme, "IYYY-IW-ID", "2025-01-8", `parsing "2025-01-8" as "IYYY-IW-ID": cannot parse "8" as "ID"`},
+			{timex.ISOWeekScheme, "IYYY-IW", "2025-1", `parsing "2025-1" as "IYYY-IW": cannot parse "1" as "IW"`},
+			{timex.BroadcastWeekScheme, "IYYY-IW", "2024-53", `parsing "2024-53" as "IYYY-IW": week is out of range [1,52]`},
+			{timex.USWeekScheme, "IYYY", "2024", `parsing "2024" as "IYYY": week is out of range [1,52]`},
+		}
+
+		for _, tt := range tests {
+			_, err := tt.scheme.Parse(tt.layout, tt.value)
+			assert.EqualError(t, err, tt.err)
+		}
+	})
+}
@@ -0,0 +1,122 @@
+package timex
+
+// YearMonth represents a specific month of a year in Gregorian calendar.
+//
+// The zero value of type YearMonth is January of year 1.
+type YearMonth struct {
+	ordinal int // ordinal represents months since January of year 1.
+}
+
+// NewYearMonth returns the year month corresponding to year and month.
+func NewYearMonth(year, month int) (YearMonth, error) {
+	if month < 1 || month > 12 {
+		return YearMonth{}, rangeError(KindMonthOutOfRange, month, 1, 12)
+	}
+	return YearMonth{ordinal: (year-1)*12 + month - 1}, nil
+}
+
+// MustNewYearMonth is like NewYearMonth but panics if the year month cannot be created.
+func MustNewYearMonth(year, month int) YearMonth {
+	ym, err := NewYearMonth(year, month)
+	if err != nil {
+		panic(`timex: NewYearMonth: ` + err.Error())
+	}
+	return ym
+}
+
+// YearMonthOf returns the year month of the date d.
+func YearMonthOf(d Date) YearMonth {
+	year, month, _ := ordinalToCalendar(d.ordinal)
+	return YearMonth{ordinal: (year-1)*12 + month - 1}
+}
+
+// YearMonth returns the year and month specified by ym.
+func (ym YearMonth) YearMonth() (year, month int) {
+	return norm1(1, ym.ordinal+1, 12)
+}
+
+// Year returns the year specified by ym.
+func (ym YearMonth) Year() int {
+	year, _ := ym.YearMonth()
+	return year
+}
+
+// Quarter returns the quarter specified by ym.
+func (ym YearMonth) Quarter() int {
+	_, month := ym.YearMonth()
+	return (month-1)/3 + 1
+}
+
+// Month returns the month specified by ym.
+func (ym YearMonth) Month() int {
+	_, month := ym.YearMonth()
+	return month
+}
+
+// Days returns the number of days in the month specified by ym.
+func (ym YearMonth) Days() int {
+	return daysInMonth(ym.YearMonth())
+}
+
+// FirstDay returns the first day of the month specified by ym.
+func (ym YearMonth) FirstDay() Date {
+	year, month := ym.YearMonth()
+	return Date{ordinal: calendarToOrdinal(year, month, 1)}
+}
+
+// LastDay returns the last day of the month specified by ym.
+func (ym YearMonth) LastDay() Date {
+	year, month := ym.YearMonth()
+	return Date{ordinal: calendarToOrdinal(year, month, daysInMonth(year, month))}
+}
+
+// Date returns the date corresponding to the day of the month specified by ym.
+func (ym YearMonth) Date(day int) (Date, error) {
+	year, month := ym.YearMonth()
+	return NewDate(year, month, day)
+}
+
+// Contains reports whether the date d is in the month specified by ym.
+func (ym YearMonth) Contains(d Date) bool {
+	return YearMonthOf(d) == ym
+}
+
+// Add returns the year month corresponding to adding the given number of years and months to ym.
+func (ym YearMonth) Add(years, months int) YearMonth {
+	return YearMonth{ordinal: ym.ordinal + years*12 + months}
+}
+
+// Next returns the month after ym.
+func (ym YearMonth) Next() YearMonth {
+	return YearMonth{ordinal: ym.ordinal + 1}
+}
+
+// Previous returns the month before ym.
+func (ym YearMonth) Previous() YearMonth {
+	return YearMonth{ordinal: ym.ordinal - 1}
+}
+
+// Sub returns the months ym-u.
+func (ym YearMonth) Sub(u YearMonth) int {
+	return ym.ordinal - u.ordinal
+}
+
+// IsZero reports whether the year month ym is the zero value, January of year 1.
+func (ym YearMonth) IsZero() bool {
+	return ym.ordinal == 0
+}
+
+// Before reports whether the year month ym is before u.
+func (ym YearMonth) Before(u YearMonth) bool {
+	return ym.ordinal < u.ordinal
+}
+
+// After reports whether the year month ym is after u.
+func (ym YearMonth) After(u YearMonth) bool {
+	return ym.ordinal > u.ordinal
+}
+
+// Equal reports whether the year month ym and u is the same month.
+func (ym YearMonth) Equal(u YearMonth) bool {
+	return ym.ordinal == u.ordinal
+}
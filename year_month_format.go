@@ -0,0 +1,141 @@
+package timex
+
+import "errors"
+
+const (
+	ISO8601YearMonth = "YYYY-MM"
+)
+
+func parseStrictISO8601YearMonth(b []byte) (YearMonth, error) {
+	if len(b) != len(ISO8601YearMonth) {
+		return YearMonth{}, &ParseError{Layout: ISO8601YearMonth, Value: string(b)}
+	}
+
+	ok := true
+	parseUint := func(s []byte) (n int) {
+		for _, c := range s {
+			if !isDigit(c) {
+				ok = false
+				return 0
+			}
+			n = n*10 + int(c-'0')
+		}
+		return n
+	}
+
+	year := parseUint(b[0:4])
+	month := parseUint(b[5:7])
+	if !ok || b[4] != '-' {
+		return YearMonth{}, &ParseError{Layout: ISO8601YearMonth, Value: string(b)}
+	}
+
+	return NewYearMonth(year, month)
+}
+
+// ParseYearMonth parses a formatted string and returns the year month it represents.
+// The layout uses the year and month tokens of ParseDate, day tokens are parsed as literal.
+//
+//	YY       01             Two-digit year
+//	YYYY   2001             Four-digit year
+//	M      1-12             Month, beginning at 1
+//	MM    01-12             Month, 2-digits
+//	MMM   Jan-Dec           The abbreviated month name
+//	MMMM  January-December  The full month name
+func ParseYearMonth(layout, value string) (YearMonth, error) {
+	f, err := parseDate(layout, value, elemYear|elemMonth, gregorianMonthNames)
+	if err != nil {
+		return YearMonth{}, err
+	}
+	yearMonth, err := NewYearMonth(f.year, f.month)
+	return yearMonth, f.parseError(err)
+}
+
+func (ym YearMonth) appendStrictISO8601(b []byte) ([]byte, error) {
+	year, month := ym.YearMonth()
+	if year < 0 || year > 9999 {
+		return nil, rangeError(KindYearOutOfRange, year, 0, 9999)
+	}
+
+	b = appendInt(b, year, 4)
+	b = append(b, '-')
+	b = appendInt(b, month, 2)
+	return b, nil
+}
+
+// Format returns a textual representation of the year month.
+// The layout uses the year and month tokens of Date.Format, day tokens are formatted as literal.
+//
+//	YY       01             Two-digit year
+//	YYYY   2001             Four-digit year
+//	M      1-12             Month, beginning at 1
+//	MM    01-12             Month, 2-digits
+//	MMM   Jan-Dec           The abbreviated month name
+//	MMMM  January-December  The full month name
+func (ym YearMonth) Format(layout string) string {
+	var f dateFields
+	f.year, f.month = ym.YearMonth()
+	bytes := make([]byte, 0, len(layout)+10)
+	bytes = appendDate(bytes, layout, elemYear|elemMonth, gregorianMonthNames, f)
+	return string(bytes)
+}
+
+// String returns the textual representation of the year month.
+func (ym YearMonth) String() string {
+	return ym.Format(ISO8601YearMonth)
+}
+
+// GoString returns the Go syntax of the year month.
+func (ym YearMonth) GoString() string {
+	year, month := ym.YearMonth()
+
+	bytes := make([]byte, 0, 32)
+
+	bytes = append(bytes, "timex.MustNewYearMonth("...)
+	bytes = appendInt(bytes, year, 0)
+
+	bytes = append(bytes, ", "...)
+	bytes = appendInt(bytes, month, 0)
+
+	bytes = append(bytes, ')')
+
+	return string(bytes)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The year month is in ISO 8601 format.
+func (ym YearMonth) MarshalText() ([]byte, error) {
+	return ym.appendStrictISO8601(make([]byte, 0, len(ISO8601YearMonth)))
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// The year month is expected to be in ISO 8601 format.
+func (ym *YearMonth) UnmarshalText(data []byte) error {
+	var err error
+	*ym, err = parseStrictISO8601YearMonth(data)
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The year month is a quoted string in ISO 8601 format.
+func (ym YearMonth) MarshalJSON() ([]byte, error) {
+	b := make([]byte, 0, len(ISO8601YearMonth)+2)
+	b = append(b, '"')
+	b, err := ym.appendStrictISO8601(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, '"')
+	return b, nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The year month is expected to be a quoted string in ISO 8601 format.
+func (ym *YearMonth) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return errors.New("YearMonth.UnmarshalJSON: input is not a JSON string")
+	}
+	return ym.UnmarshalText(data[1 : len(data)-1])
+}
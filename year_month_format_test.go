@@ -0,0 +1,156 @@
+package timex_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/invzhi/timex"
+)
+
+func TestParseYearMonth(t *testing.T) {
+	tests := []struct {
+		layout string
+		value  string
+	}{
+		{timex.ISO8601YearMonth, "2024-03"},
+		{"YYYYMM", "202403"},
+		{"YY-M", "24-3"},
+		{"MMM YYYY", "Mar 2024"},
+		{"MMMM YYYY", "March 2024"},
+		{"MM/YYYY", "03/2024"},
+		{"YYYY-MM-DD", "2024-03-DD"},
+		// Chinese
+		{"YYYY年M月", "2024年3月"},
+	}
+
+	for _, tt := range tests {
+		ym, err := timex.ParseYearMonth(tt.layout, tt.value)
+		assert.NoError(t, err)
+		assert.Equal(t, timex.MustNewYearMonth(2024, 3), ym)
+		assert.Equal(t, tt.value, ym.Format(tt.layout))
+	}
+}
+
+func TestParseYearMonthErrors(t *testing.T) {
+	tests := []struct {
+		layout    string
+		value     string
+		errString string
+	}{
+		{timex.ISO8601YearMonth, "2024-3", `parsing "2024-3" as "YYYY-MM": cannot parse "3" as "MM"`},
+		{timex.ISO8601YearMonth, "2024/03", `parsing "2024/03" as "YYYY-MM": cannot parse "/03" as "-"`},
+		{"DD/YYYY-MM", "01/2024-03", `parsing "01/2024-03" as "DD/YYYY-MM": cannot parse "01/2024-03" as "DD/"`},
+		{timex.ISO8601YearMonth, "2024-13", `parsing "2024-13" as "YYYY-MM": month is out of range [1,12]`},
+	}
+
+	for _, tt := range tests {
+		_, err := timex.ParseYearMonth(tt.layout, tt.value)
+		assert.EqualError(t, err, tt.errString)
+	}
+}
+
+func FuzzParseYearMonth(f *testing.F) {
+	f.Add("YYYY-MM", "2024-03")
+	f.Add(" YYYY-MM", "")
+	f.Fuzz(func(t *testing.T, layout, value string) {
+		assert.NotPanics(t, func() {
+			_, _ = timex.ParseYearMonth(layout, value)
+		})
+	})
+}
+
+func TestYearMonthString(t *testing.T) {
+	tests := []struct {
+		year, month int
+		str, goStr  string
+	}{
+		{2024, 3, "2024-03", "timex.MustNewYearMonth(2024, 3)"},
+		{1, 12, "0001-12", "timex.MustNewYearMonth(1, 12)"},
+		{0, 1, "0000-01", "timex.MustNewYearMonth(0, 1)"},
+		{-2000, 1, "-2000-01", "timex.MustNewYearMonth(-2000, 1)"},
+		{10001, 1, "10001-01", "timex.MustNewYearMonth(10001, 1)"},
+	}
+
+	for _, tt := range tests {
+		ym := timex.MustNewYearMonth(tt.year, tt.month)
+		assert.Equal(t, tt.str, ym.String())
+		assert.Equal(t, tt.goStr, ym.GoString())
+	}
+}
+
+func TestYearMonthMarshalJSON(t *testing.T) {
+	tests := []struct {
+		year, month int
+		s           string
+	}{
+		{2024, 3, `"2024-03"`},
+		{1, 12, `"0001-12"`},
+		{0, 1, `"0000-01"`},
+	}
+
+	for _, tt := range tests {
+		ym1 := timex.MustNewYearMonth(tt.year, tt.month)
+		bytes, err := ym1.MarshalJSON()
+		assert.NoError(t, err)
+		assert.Equal(t, tt.s, string(bytes))
+
+		var ym2 timex.YearMonth
+		err = ym2.UnmarshalJSON(bytes)
+		assert.NoError(t, err)
+		assert.Equal(t, ym1, ym2)
+
+		text, err := ym1.MarshalText()
+		assert.NoError(t, err)
+		assert.Equal(t, tt.s[1:len(tt.s)-1], string(text))
+
+		var ym3 timex.YearMonth
+		err = ym3.UnmarshalText(text)
+		assert.NoError(t, err)
+		assert.Equal(t, ym1, ym3)
+	}
+
+	t.Run("Null", func(t *testing.T) {
+		var ym timex.YearMonth
+		err := ym.UnmarshalJSON([]byte("null"))
+		assert.NoError(t, err)
+		assert.True(t, ym.IsZero())
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		_, err := timex.MustNewYearMonth(10000, 1).MarshalJSON()
+		assert.EqualError(t, err, "year is out of range [0,9999]")
+		_, err = timex.MustNewYearMonth(-1, 1).MarshalText()
+		assert.EqualError(t, err, "year is out of range [0,9999]")
+	})
+}
+
+func TestYearMonthUnmarshalJSONError(t *testing.T) {
+	tests := []struct {
+		s         string
+		errString string
+	}{
+		{`2024-03`, `YearMonth.UnmarshalJSON: input is not a JSON string`},
+		{`""`, `parsing "" as "YYYY-MM"`},
+		{`"2024-3"`, `parsing "2024-3" as "YYYY-MM"`},
+		{`"2024-03-01"`, `parsing "2024-03-01" as "YYYY-MM"`},
+		{`"2024/03"`, `parsing "2024/03" as "YYYY-MM"`},
+		{`"-124-03"`, `parsing "-124-03" as "YYYY-MM"`},
+		{`"2024-13"`, `month is out of range [1,12]`},
+	}
+
+	for _, tt := range tests {
+		var ym timex.YearMonth
+		err := ym.UnmarshalJSON([]byte(tt.s))
+		assert.EqualError(t, err, tt.errString)
+	}
+}
+
+func FuzzYearMonthUnmarshalJSON(f *testing.F) {
+	f.Fuzz(func(t *testing.T, data []byte) {
+		assert.NotPanics(t, func() {
+			var ym timex.YearMonth
+			_ = ym.UnmarshalJSON(data)
+		})
+	})
+}
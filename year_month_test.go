@@ -0,0 +1,135 @@
+package timex_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/invzhi/timex"
+)
+
+func TestNewYearMonth(t *testing.T) {
+	tests := []struct {
+		year, month int
+		quarter     int
+		days        int
+	}{
+		{-1, 12, 4, 31},
+		{0, 2, 1, 29},
+		{1, 1, 1, 31},
+		{2023, 2, 1, 28},
+		{2024, 2, 1, 29},
+		{2024, 4, 2, 30},
+		{2024, 12, 4, 31},
+	}
+
+	for _, tt := range tests {
+		ym := timex.MustNewYearMonth(tt.year, tt.month)
+
+		year, month := ym.YearMonth()
+		assert.Equal(t, tt.year, year)
+		assert.Equal(t, tt.month, month)
+
+		assert.Equal(t, tt.year, ym.Year())
+		assert.Equal(t, tt.month, ym.Month())
+		assert.Equal(t, tt.quarter, ym.Quarter())
+		assert.Equal(t, tt.days, ym.Days())
+
+		first := timex.MustNewDate(tt.year, tt.month, 1)
+		last := timex.MustNewDate(tt.year, tt.month, tt.days)
+		assert.Equal(t, first, ym.FirstDay())
+		assert.Equal(t, last, ym.LastDay())
+		assert.Equal(t, ym, timex.YearMonthOf(first))
+		assert.Equal(t, ym, timex.YearMonthOf(last))
+
+		assert.True(t, ym.Contains(first))
+		assert.True(t, ym.Contains(last))
+		assert.False(t, ym.Contains(first.AddDays(-1)))
+		assert.False(t, ym.Contains(last.AddDays(1)))
+
+		date, err := ym.Date(tt.days)
+		assert.NoError(t, err)
+		assert.Equal(t, last, date)
+	}
+
+	assert.True(t, timex.YearMonth{}.IsZero())
+	assert.Equal(t, timex.MustNewYearMonth(1, 1), timex.YearMonth{})
+
+	t.Run("Errors", func(t *testing.T) {
+		errTests := []struct {
+			year, month int
+			errString   string
+		}{
+			{2000, -1, "month is out of range [1,12]"},
+			{2000, 0, "month is out of range [1,12]"},
+			{2000, 13, "month is out of range [1,12]"},
+		}
+
+		for _, tt := range errTests {
+			_, err := timex.NewYearMonth(tt.year, tt.month)
+			assert.EqualError(t, err, tt.errString)
+
+			assert.Panicsf(t, func() {
+				_ = timex.MustNewYearMonth(tt.year, tt.month)
+			}, "timex: NewYearMonth: "+tt.errString)
+		}
+
+		_, err := timex.MustNewYearMonth(2023, 2).Date(29)
+		assert.EqualError(t, err, "day is out of range [1,28]")
+	})
+}
+
+func TestYearMonthAdd(t *testing.T) {
+	tests := []struct {
+		years, months int
+		ym            timex.YearMonth
+	}{
+		{0, 0, timex.MustNewYearMonth(2024, 3)},
+		{0, 1, timex.MustNewYearMonth(2024, 4)},
+		{0, 10, timex.MustNewYearMonth(2025, 1)},
+		{0, -3, timex.MustNewYearMonth(2023, 12)},
+		{1, -15, timex.MustNewYearMonth(2023, 12)},
+		{-2024, 0, timex.MustNewYearMonth(0, 3)},
+		{-2025, 0, timex.MustNewYearMonth(-1, 3)},
+	}
+
+	ym := timex.MustNewYearMonth(2024, 3)
+	for _, tt := range tests {
+		u := ym.Add(tt.years, tt.months)
+		assert.Equal(t, tt.ym, u)
+		assert.Equal(t, tt.years*12+tt.months, u.Sub(ym))
+	}
+
+	assert.Equal(t, timex.MustNewYearMonth(2024, 4), ym.Next())
+	assert.Equal(t, timex.MustNewYearMonth(2024, 2), ym.Previous())
+	assert.Equal(t, timex.MustNewYearMonth(2025, 1), timex.MustNewYearMonth(2024, 12).Next())
+	assert.Equal(t, timex.MustNewYearMonth(-1, 12), timex.MustNewYearMonth(0, 1).Previous())
+
+	t.Run("Iterate", func(t *testing.T) {
+		var days int
+		for ym := timex.MustNewYearMonth(2024, 1); ym.Year() == 2024; ym = ym.Next() {
+			days += ym.Days()
+		}
+		assert.Equal(t, 366, days)
+	})
+}
+
+func TestYearMonthBeforeAfter(t *testing.T) {
+	tests := []struct {
+		ym1, ym2      timex.YearMonth
+		before, after bool
+	}{
+		{timex.MustNewYearMonth(2024, 1), timex.MustNewYearMonth(2024, 1), false, false},
+		{timex.MustNewYearMonth(2024, 1), timex.MustNewYearMonth(2024, 2), true, false},
+		{timex.MustNewYearMonth(2023, 12), timex.MustNewYearMonth(2024, 1), true, false},
+		{timex.MustNewYearMonth(2024, 1), timex.MustNewYearMonth(2023, 12), false, true},
+		{timex.MustNewYearMonth(-1, 1), timex.MustNewYearMonth(0, 1), true, false},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.before, tt.ym1.Before(tt.ym2))
+		assert.Equal(t, tt.after, tt.ym1.After(tt.ym2))
+		assert.Equal(t, !tt.before && !tt.after, tt.ym1.Equal(tt.ym2))
+		assert.Equal(t, !tt.before && !tt.after, tt.ym2.Equal(tt.ym1))
+	}
+}
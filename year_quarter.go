@@ -0,0 +1,105 @@
+package timex
+
+// YearQuarter represents a specific quarter of a year in Gregorian calendar.
+//
+// The zero value of type YearQuarter is the first quarter of year 1.
+type YearQuarter struct {
+	ordinal int // ordinal represents quarters since the first quarter of year 1.
+}
+
+// NewYearQuarter returns the year quarter corresponding to year and quarter.
+func NewYearQuarter(year, quarter int) (YearQuarter, error) {
+	if quarter < 1 || quarter > 4 {
+		return YearQuarter{}, rangeError(KindQuarterOutOfRange, quarter, 1, 4)
+	}
+	return YearQuarter{ordinal: (year-1)*4 + quarter - 1}, nil
+}
+
+// MustNewYearQuarter is like NewYearQuarter but panics if the year quarter cannot be created.
+func MustNewYearQuarter(year, quarter int) YearQuarter {
+	yq, err := NewYearQuarter(year, quarter)
+	if err != nil {
+		panic(`timex: NewYearQuarter: ` + err.Error())
+	}
+	return yq
+}
+
+// YearQuarterOf returns the year quarter of the date d.
+func YearQuarterOf(d Date) YearQuarter {
+	year, month, _ := ordinalToCalendar(d.ordinal)
+	return YearQuarter{ordinal: (year-1)*4 + (month-1)/3}
+}
+
+// YearQuarter returns the year and quarter specified by yq.
+func (yq YearQuarter) YearQuarter() (year, quarter int) {
+	return norm1(1, yq.ordinal+1, 4)
+}
+
+// Year returns the year specified by yq.
+func (yq YearQuarter) Year() int {
+	year, _ := yq.YearQuarter()
+	return year
+}
+
+// Quarter returns the quarter specified by yq.
+func (yq YearQuarter) Quarter() int {
+	_, quarter := yq.YearQuarter()
+	return quarter
+}
+
+// Start returns the first day of the quarter specified by yq.
+func (yq YearQuarter) Start() Date {
+	year, quarter := yq.YearQuarter()
+	return Date{ordinal: calendarToOrdinal(year, quarter*3-2, 1)}
+}
+
+// End returns the last day of the quarter specified by yq.
+func (yq YearQuarter) End() Date {
+	year, quarter := yq.YearQuarter()
+	return Date{ordinal: calendarToOrdinal(year, quarter*3, daysInMonth(year, quarter*3))}
+}
+
+// Contains reports whether the date d is in the quarter specified by yq.
+func (yq YearQuarter) Contains(d Date) bool {
+	return YearQuarterOf(d) == yq
+}
+
+// Add returns the year quarter corresponding to adding the given number of years and quarters to yq.
+func (yq YearQuarter) Add(years, quarters int) YearQuarter {
+	return YearQuarter{ordinal: yq.ordinal + years*4 + quarters}
+}
+
+// Next returns the quarter after yq.
+func (yq YearQuarter) Next() YearQuarter {
+	return YearQuarter{ordinal: yq.ordinal + 1}
+}
+
+// Previous returns the quarter before yq.
+func (yq YearQuarter) Previous() YearQuarter {
+	return YearQuarter{ordinal: yq.ordinal - 1}
+}
+
+// Sub returns the quarters yq-u.
+func (yq YearQuarter) Sub(u YearQuarter) int {
+	return yq.ordinal - u.ordinal
+}
+
+// IsZero reports whether the year quarter yq is the zero value, the first quarter of year 1.
+func (yq YearQuarter) IsZero() bool {
+	return yq.ordinal == 0
+}
+
+// Before reports whether the year quarter yq is before u.
+func (yq YearQuarter) Before(u YearQuarter) bool {
+	return yq.ordinal < u.ordinal
+}
+
+// After reports whether the year quarter yq is after u.
+func (yq YearQuarter) After(u YearQuarter) bool {
+	return yq.ordinal > u.ordinal
+}
+
+// Equal reports whether the year quarter yq and u is the same quarter.
+func (yq YearQuarter) Equal(u YearQuarter) bool {
+	return yq.ordinal == u.ordinal
+}
@@ -0,0 +1,104 @@
+package timex
+
+import "errors"
+
+// yearQuarterLayout describes the textual representation of YearQuarter, such as 2024-Q1.
+const yearQuarterLayout = "YYYY-Qq"
+
+// ParseYearQuarter parses a string such as 2024-Q1 and returns the year quarter it represents.
+// Unlike ParseYearMonth, it takes no layout, the value must be exactly in this format which String returns.
+func ParseYearQuarter(value string) (YearQuarter, error) {
+	if len(value) != len(yearQuarterLayout) || value[4] != '-' || value[5] != 'Q' {
+		return YearQuarter{}, &ParseError{Layout: yearQuarterLayout, Value: value}
+	}
+
+	year, s, ok := atoi(value[:4], 4, 4)
+	if !ok || len(s) != 0 || !isDigit(value[6]) {
+		return YearQuarter{}, &ParseError{Layout: yearQuarterLayout, Value: value}
+	}
+
+	var spans elemSpans
+	spans[KindYearOutOfRange] = elemSpan{layoutElem: "YYYY", valueElem: value[:4]}
+	spans[KindQuarterOutOfRange] = elemSpan{layoutElem: "q", valueElem: value[6:], offset: 6}
+	yearQuarter, err := NewYearQuarter(year, fromDigit(value[6]))
+	return yearQuarter, spans.parseError(yearQuarterLayout, value, err)
+}
+
+func (yq YearQuarter) appendFormat(b []byte) []byte {
+	year, quarter := yq.YearQuarter()
+
+	b = appendInt(b, year, 4)
+	b = append(b, '-', 'Q')
+	b = appendInt(b, quarter, 0)
+	return b
+}
+
+func (yq YearQuarter) appendStrictFormat(b []byte) ([]byte, error) {
+	if year := yq.Year(); year < 0 || year > 9999 {
+		return nil, rangeError(KindYearOutOfRange, year, 0, 9999)
+	}
+	return yq.appendFormat(b), nil
+}
+
+// String returns the textual representation of the year quarter, such as 2024-Q1.
+func (yq YearQuarter) String() string {
+	b := make([]byte, 0, len(yearQuarterLayout))
+	b = yq.appendFormat(b)
+	return string(b)
+}
+
+// GoString returns the Go syntax of the year quarter.
+func (yq YearQuarter) GoString() string {
+	year, quarter := yq.YearQuarter()
+
+	bytes := make([]byte, 0, 32)
+
+	bytes = append(bytes, "timex.MustNewYearQuarter("...)
+	bytes = appendInt(bytes, year, 0)
+
+	bytes = append(bytes, ", "...)
+	bytes = appendInt(bytes, quarter, 0)
+
+	bytes = append(bytes, ')')
+
+	return string(bytes)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The year quarter is in the format such as 2024-Q1.
+func (yq YearQuarter) MarshalText() ([]byte, error) {
+	return yq.appendStrictFormat(make([]byte, 0, len(yearQuarterLayout)))
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// The year quarter is expected to be in the format such as 2024-Q1.
+func (yq *YearQuarter) UnmarshalText(data []byte) error {
+	var err error
+	*yq, err = ParseYearQuarter(string(data))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The year quarter is a quoted string in the format such as 2024-Q1.
+func (yq YearQuarter) MarshalJSON() ([]byte, error) {
+	b := make([]byte, 0, len(yearQuarterLayout)+2)
+	b = append(b, '"')
+	b, err := yq.appendStrictFormat(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, '"')
+	return b, nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The year quarter is expected to be a quoted string in the format such as 2024-Q1.
+func (yq *YearQuarter) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return errors.New("YearQuarter.UnmarshalJSON: input is not a JSON string")
+	}
+	return yq.UnmarshalText(data[1 : len(data)-1])
+}
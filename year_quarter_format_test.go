@@ -0,0 +1,114 @@
+package timex_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/invzhi/timex"
+)
+
+func TestYearQuarterString(t *testing.T) {
+	tests := []struct {
+		year, quarter int
+		str, goStr    string
+	}{
+		{2024, 1, "2024-Q1", "timex.MustNewYearQuarter(2024, 1)"},
+		{1, 4, "0001-Q4", "timex.MustNewYearQuarter(1, 4)"},
+		{-2000, 2, "-2000-Q2", "timex.MustNewYearQuarter(-2000, 2)"},
+	}
+
+	for _, tt := range tests {
+		yq := timex.MustNewYearQuarter(tt.year, tt.quarter)
+		assert.Equal(t, tt.str, yq.String())
+		assert.Equal(t, tt.goStr, yq.GoString())
+	}
+}
+
+func TestParseYearQuarterErrors(t *testing.T) {
+	tests := []struct {
+		value     string
+		errString string
+	}{
+		{"", `parsing "" as "YYYY-Qq"`},
+		{"2024-Q", `parsing "2024-Q" as "YYYY-Qq"`},
+		{"2024Q1", `parsing "2024Q1" as "YYYY-Qq"`},
+		{"2024-q1", `parsing "2024-q1" as "YYYY-Qq"`},
+		{"+024-Q1", `parsing "+024-Q1" as "YYYY-Qq"`},
+		{"2024-QA", `parsing "2024-QA" as "YYYY-Qq"`},
+		{"2024-Q5", `parsing "2024-Q5" as "YYYY-Qq": quarter is out of range [1,4]`},
+	}
+
+	for _, tt := range tests {
+		_, err := timex.ParseYearQuarter(tt.value)
+		assert.EqualError(t, err, tt.errString)
+	}
+
+	_, err := timex.ParseYearQuarter("2024-Q5")
+	var e *timex.ParseError
+	if assert.ErrorAs(t, err, &e) {
+		assert.Equal(t, timex.KindQuarterOutOfRange, e.Kind)
+		assert.Equal(t, 6, e.Offset)
+		assert.Equal(t, "q", e.LayoutElem)
+		assert.Equal(t, "5", e.ValueElem)
+	}
+}
+
+func TestYearQuarterMarshalJSON(t *testing.T) {
+	tests := []struct {
+		year, quarter int
+		s             string
+	}{
+		{2024, 1, `"2024-Q1"`},
+		{1, 4, `"0001-Q4"`},
+		{0, 2, `"0000-Q2"`},
+	}
+
+	for _, tt := range tests {
+		yq1 := timex.MustNewYearQuarter(tt.year, tt.quarter)
+		bytes, err := yq1.MarshalJSON()
+		assert.NoError(t, err)
+		assert.Equal(t, tt.s, string(bytes))
+
+		var yq2 timex.YearQuarter
+		err = yq2.UnmarshalJSON(bytes)
+		assert.NoError(t, err)
+		assert.Equal(t, yq1, yq2)
+
+		text, err := yq1.MarshalText()
+		assert.NoError(t, err)
+		assert.Equal(t, tt.s[1:len(tt.s)-1], string(text))
+
+		var yq3 timex.YearQuarter
+		err = yq3.UnmarshalText(text)
+		assert.NoError(t, err)
+		assert.Equal(t, yq1, yq3)
+	}
+
+	t.Run("Null", func(t *testing.T) {
+		var yq timex.YearQuarter
+		err := yq.UnmarshalJSON([]byte("null"))
+		assert.NoError(t, err)
+		assert.True(t, yq.IsZero())
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		_, err := timex.MustNewYearQuarter(10000, 1).MarshalJSON()
+		assert.EqualError(t, err, "year is out of range [0,9999]")
+		_, err = timex.MustNewYearQuarter(-1, 1).MarshalText()
+		assert.EqualError(t, err, "year is out of range [0,9999]")
+
+		var yq timex.YearQuarter
+		err = yq.UnmarshalJSON([]byte("2024-Q1"))
+		assert.EqualError(t, err, "YearQuarter.UnmarshalJSON: input is not a JSON string")
+	})
+}
+
+func FuzzParseYearQuarter(f *testing.F) {
+	f.Add("2024-Q1")
+	f.Fuzz(func(t *testing.T, value string) {
+		assert.NotPanics(t, func() {
+			_, _ = timex.ParseYearQuarter(value)
+		})
+	})
+}
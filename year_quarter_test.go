@@ -0,0 +1,101 @@
+package timex_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/invzhi/timex"
+)
+
+func TestNewYearQuarter(t *testing.T) {
+	tests := []struct {
+		year, quarter int
+		start, end    timex.Date
+	}{
+		{2024, 1, timex.MustNewDate(2024, 1, 1), timex.MustNewDate(2024, 3, 31)},
+		{2024, 2, timex.MustNewDate(2024, 4, 1), timex.MustNewDate(2024, 6, 30)},
+		{2024, 3, timex.MustNewDate(2024, 7, 1), timex.MustNewDate(2024, 9, 30)},
+		{2024, 4, timex.MustNewDate(2024, 10, 1), timex.MustNewDate(2024, 12, 31)},
+		{0, 1, timex.MustNewDate(0, 1, 1), timex.MustNewDate(0, 3, 31)},
+		{-1, 4, timex.MustNewDate(-1, 10, 1), timex.MustNewDate(-1, 12, 31)},
+	}
+
+	for _, tt := range tests {
+		yq := timex.MustNewYearQuarter(tt.year, tt.quarter)
+
+		year, quarter := yq.YearQuarter()
+		assert.Equal(t, tt.year, year)
+		assert.Equal(t, tt.quarter, quarter)
+
+		assert.Equal(t, tt.year, yq.Year())
+		assert.Equal(t, tt.quarter, yq.Quarter())
+
+		assert.Equal(t, tt.start, yq.Start())
+		assert.Equal(t, tt.end, yq.End())
+		assert.Equal(t, yq, timex.YearQuarterOf(tt.start))
+		assert.Equal(t, yq, timex.YearQuarterOf(tt.end))
+
+		assert.True(t, yq.Contains(tt.start))
+		assert.True(t, yq.Contains(tt.end))
+		assert.False(t, yq.Contains(tt.start.AddDays(-1)))
+		assert.False(t, yq.Contains(tt.end.AddDays(1)))
+	}
+
+	assert.True(t, timex.YearQuarter{}.IsZero())
+	assert.Equal(t, timex.MustNewYearQuarter(1, 1), timex.YearQuarter{})
+
+	t.Run("Errors", func(t *testing.T) {
+		for _, quarter := range []int{-1, 0, 5} {
+			_, err := timex.NewYearQuarter(2024, quarter)
+			assert.EqualError(t, err, "quarter is out of range [1,4]")
+
+			assert.Panicsf(t, func() {
+				_ = timex.MustNewYearQuarter(2024, quarter)
+			}, "timex: NewYearQuarter: quarter is out of range [1,4]")
+		}
+	})
+}
+
+func TestYearQuarterAdd(t *testing.T) {
+	tests := []struct {
+		years, quarters int
+		yq              timex.YearQuarter
+	}{
+		{0, 0, timex.MustNewYearQuarter(2024, 2)},
+		{0, 2, timex.MustNewYearQuarter(2024, 4)},
+		{0, 3, timex.MustNewYearQuarter(2025, 1)},
+		{0, -2, timex.MustNewYearQuarter(2023, 4)},
+		{1, -5, timex.MustNewYearQuarter(2024, 1)},
+		{-2025, 0, timex.MustNewYearQuarter(-1, 2)},
+	}
+
+	yq := timex.MustNewYearQuarter(2024, 2)
+	for _, tt := range tests {
+		u := yq.Add(tt.years, tt.quarters)
+		assert.Equal(t, tt.yq, u)
+		assert.Equal(t, tt.years*4+tt.quarters, u.Sub(yq))
+	}
+
+	assert.Equal(t, timex.MustNewYearQuarter(2024, 3), yq.Next())
+	assert.Equal(t, timex.MustNewYearQuarter(2024, 1), yq.Previous())
+}
+
+func TestYearQuarterBeforeAfter(t *testing.T) {
+	tests := []struct {
+		yq1, yq2      timex.YearQuarter
+		before, after bool
+	}{
+		{timex.MustNewYearQuarter(2024, 1), timex.MustNewYearQuarter(2024, 1), false, false},
+		{timex.MustNewYearQuarter(2024, 1), timex.MustNewYearQuarter(2024, 2), true, false},
+		{timex.MustNewYearQuarter(2023, 4), timex.MustNewYearQuarter(2024, 1), true, false},
+		{timex.MustNewYearQuarter(2024, 1), timex.MustNewYearQuarter(2023, 4), false, true},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.before, tt.yq1.Before(tt.yq2))
+		assert.Equal(t, tt.after, tt.yq1.After(tt.yq2))
+		assert.Equal(t, !tt.before && !tt.after, tt.yq1.Equal(tt.yq2))
+		assert.Equal(t, !tt.before && !tt.after, tt.yq2.Equal(tt.yq1))
+	}
+}
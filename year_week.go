@@ -0,0 +1,120 @@
+package timex
+
+import (
+	"time"
+)
+
+// YearWeek represents a specific week of a week-based year in ISO 8601.
+// The week starts on monday, and the first week of a year is the week containing its first thursday.
+//
+// The zero value of type YearWeek is the first week of year 1, which starts on January 1 of year 1.
+type YearWeek struct {
+	ordinal int // ordinal represents weeks since the week starts on January 1 of year 1.
+}
+
+// NewYearWeek returns the year week corresponding to week-based year and week.
+func NewYearWeek(year, week int) (YearWeek, error) {
+	if weeks := isoWeeksInYear(year); week < 1 || week > weeks {
+		return YearWeek{}, rangeError(KindWeekOutOfRange, week, 1, weeks)
+	}
+
+	// January 4 is always in the first week.
+	monday := Date{ordinal: calendarToOrdinal(year, 1, 4)}.StartOfISOWeek()
+	return YearWeek{ordinal: monday.ordinal/7 + week - 1}, nil
+}
+
+// MustNewYearWeek is like NewYearWeek but panics if the year week cannot be created.
+func MustNewYearWeek(year, week int) YearWeek {
+	yw, err := NewYearWeek(year, week)
+	if err != nil {
+		panic(`timex: NewYearWeek: ` + err.Error())
+	}
+	return yw
+}
+
+// YearWeekOf returns the year week of the date d.
+func YearWeekOf(d Date) YearWeek {
+	return YearWeek{ordinal: d.StartOfISOWeek().ordinal / 7} // Day 0 is monday.
+}
+
+// YearWeek returns the week-based year and week specified by yw.
+func (yw YearWeek) YearWeek() (year, week int) {
+	return yw.Start().ISOWeek()
+}
+
+// Year returns the week-based year specified by yw.
+func (yw YearWeek) Year() int {
+	year, _ := yw.YearWeek()
+	return year
+}
+
+// Week returns the week specified by yw.
+func (yw YearWeek) Week() int {
+	_, week := yw.YearWeek()
+	return week
+}
+
+// Start returns the monday of the week specified by yw.
+func (yw YearWeek) Start() Date {
+	return Date{ordinal: yw.ordinal * 7}
+}
+
+// End returns the sunday of the week specified by yw.
+func (yw YearWeek) End() Date {
+	return Date{ordinal: yw.ordinal*7 + 6}
+}
+
+// Contains reports whether the date d is in the week specified by yw.
+func (yw YearWeek) Contains(d Date) bool {
+	return YearWeekOf(d) == yw
+}
+
+// Add returns the year week corresponding to adding the given number of weeks to yw.
+func (yw YearWeek) Add(weeks int) YearWeek {
+	return YearWeek{ordinal: yw.ordinal + weeks}
+}
+
+// Next returns the week after yw.
+func (yw YearWeek) Next() YearWeek {
+	return YearWeek{ordinal: yw.ordinal + 1}
+}
+
+// Previous returns the week before yw.
+func (yw YearWeek) Previous() YearWeek {
+	return YearWeek{ordinal: yw.ordinal - 1}
+}
+
+// Sub returns the weeks yw-u.
+func (yw YearWeek) Sub(u YearWeek) int {
+	return yw.ordinal - u.ordinal
+}
+
+// IsZero reports whether the year week yw is the zero value, the first week of year 1.
+func (yw YearWeek) IsZero() bool {
+	return yw.ordinal == 0
+}
+
+// Before reports whether the year week yw is before u.
+func (yw YearWeek) Before(u YearWeek) bool {
+	return yw.ordinal < u.ordinal
+}
+
+// After reports whether the year week yw is after u.
+func (yw YearWeek) After(u YearWeek) bool {
+	return yw.ordinal > u.ordinal
+}
+
+// Equal reports whether the year week yw and u is the same week.
+func (yw YearWeek) Equal(u YearWeek) bool {
+	return yw.ordinal == u.ordinal
+}
+
+// isoWeeksInYear returns the number of weeks in the ISO 8601 week-based year.
+// A year has 53 weeks if it starts on thursday, or it is a leap year which starts on wednesday.
+func isoWeeksInYear(year int) int {
+	weekday := Date{ordinal: calendarToOrdinal(year, 1, 1)}.Weekday()
+	if weekday == time.Thursday || weekday == time.Wednesday && isLeap(year) {
+		return 53
+	}
+	return 52
+}
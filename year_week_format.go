@@ -0,0 +1,108 @@
+package timex
+
+import "errors"
+
+// yearWeekLayout describes the textual representation of YearWeek in ISO 8601, such as 2024-W05.
+const yearWeekLayout = "YYYY-Www"
+
+// ParseYearWeek parses a string in ISO 8601 such as 2024-W05 and returns the year week it represents.
+// Unlike ParseYearMonth, it takes no layout, the value must be exactly in this format which String returns.
+func ParseYearWeek(value string) (YearWeek, error) {
+	if len(value) != len(yearWeekLayout) || value[4] != '-' || value[5] != 'W' {
+		return YearWeek{}, &ParseError{Layout: yearWeekLayout, Value: value}
+	}
+
+	year, s, ok := atoi(value[:4], 4, 4)
+	if !ok || len(s) != 0 {
+		return YearWeek{}, &ParseError{Layout: yearWeekLayout, Value: value}
+	}
+	week, s, ok := atoi(value[6:], 2, 2)
+	if !ok || len(s) != 0 {
+		return YearWeek{}, &ParseError{Layout: yearWeekLayout, Value: value}
+	}
+
+	var spans elemSpans
+	spans[KindYearOutOfRange] = elemSpan{layoutElem: "YYYY", valueElem: value[:4]}
+	spans[KindWeekOutOfRange] = elemSpan{layoutElem: "ww", valueElem: value[6:], offset: 6}
+	yearWeek, err := NewYearWeek(year, week)
+	return yearWeek, spans.parseError(yearWeekLayout, value, err)
+}
+
+func (yw YearWeek) appendISO8601(b []byte) []byte {
+	year, week := yw.YearWeek()
+
+	b = appendInt(b, year, 4)
+	b = append(b, '-', 'W')
+	b = appendInt(b, week, 2)
+	return b
+}
+
+func (yw YearWeek) appendStrictISO8601(b []byte) ([]byte, error) {
+	if year := yw.Year(); year < 0 || year > 9999 {
+		return nil, rangeError(KindYearOutOfRange, year, 0, 9999)
+	}
+	return yw.appendISO8601(b), nil
+}
+
+// String returns the textual representation of the year week in ISO 8601, such as 2024-W05.
+func (yw YearWeek) String() string {
+	b := make([]byte, 0, len(yearWeekLayout))
+	b = yw.appendISO8601(b)
+	return string(b)
+}
+
+// GoString returns the Go syntax of the year week.
+func (yw YearWeek) GoString() string {
+	year, week := yw.YearWeek()
+
+	bytes := make([]byte, 0, 32)
+
+	bytes = append(bytes, "timex.MustNewYearWeek("...)
+	bytes = appendInt(bytes, year, 0)
+
+	bytes = append(bytes, ", "...)
+	bytes = appendInt(bytes, week, 0)
+
+	bytes = append(bytes, ')')
+
+	return string(bytes)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The year week is in ISO 8601 format.
+func (yw YearWeek) MarshalText() ([]byte, error) {
+	return yw.appendStrictISO8601(make([]byte, 0, len(yearWeekLayout)))
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface.
+// The year week is expected to be in ISO 8601 format.
+func (yw *YearWeek) UnmarshalText(data []byte) error {
+	var err error
+	*yw, err = ParseYearWeek(string(data))
+	return err
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The year week is a quoted string in ISO 8601 format.
+func (yw YearWeek) MarshalJSON() ([]byte, error) {
+	b := make([]byte, 0, len(yearWeekLayout)+2)
+	b = append(b, '"')
+	b, err := yw.appendStrictISO8601(b)
+	if err != nil {
+		return nil, err
+	}
+	b = append(b, '"')
+	return b, nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The year week is expected to be a quoted string in ISO 8601 format.
+func (yw *YearWeek) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		return nil
+	}
+	if len(data) < 2 || data[0] != '"' || data[len(data)-1] != '"' {
+		return errors.New("YearWeek.UnmarshalJSON: input is not a JSON string")
+	}
+	return yw.UnmarshalText(data[1 : len(data)-1])
+}
@@ -0,0 +1,118 @@
+package timex_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/invzhi/timex"
+)
+
+func TestYearWeekString(t *testing.T) {
+	tests := []struct {
+		year, week int
+		str, goStr string
+	}{
+		{2024, 5, "2024-W05", "timex.MustNewYearWeek(2024, 5)"},
+		{2020, 53, "2020-W53", "timex.MustNewYearWeek(2020, 53)"},
+		{1, 1, "0001-W01", "timex.MustNewYearWeek(1, 1)"},
+	}
+
+	for _, tt := range tests {
+		yw := timex.MustNewYearWeek(tt.year, tt.week)
+		assert.Equal(t, tt.str, yw.String())
+		assert.Equal(t, tt.goStr, yw.GoString())
+
+		parsed, err := timex.ParseYearWeek(tt.str)
+		assert.NoError(t, err)
+		assert.Equal(t, yw, parsed)
+	}
+}
+
+func TestParseYearWeekErrors(t *testing.T) {
+	tests := []struct {
+		value     string
+		errString string
+	}{
+		{"", `parsing "" as "YYYY-Www"`},
+		{"2024-W5", `parsing "2024-W5" as "YYYY-Www"`},
+		{"2024W05", `parsing "2024W05" as "YYYY-Www"`},
+		{"2024-w05", `parsing "2024-w05" as "YYYY-Www"`},
+		{"-024-W05", `parsing "-024-W05" as "YYYY-Www"`},
+		{"2024-W+5", `parsing "2024-W+5" as "YYYY-Www"`},
+		{"2024-W53", `parsing "2024-W53" as "YYYY-Www": week is out of range [1,52]`},
+	}
+
+	for _, tt := range tests {
+		_, err := timex.ParseYearWeek(tt.value)
+		assert.EqualError(t, err, tt.errString)
+	}
+
+	_, err := timex.ParseYearWeek("2024-W54")
+	var e *timex.ParseError
+	if assert.ErrorAs(t, err, &e) {
+		assert.Equal(t, timex.KindWeekOutOfRange, e.Kind)
+		assert.Equal(t, 6, e.Offset)
+		assert.Equal(t, "ww", e.LayoutElem)
+		assert.Equal(t, "54", e.ValueElem)
+	}
+}
+
+func TestYearWeekMarshalJSON(t *testing.T) {
+	tests := []struct {
+		year, week int
+		s          string
+	}{
+		{2024, 5, `"2024-W05"`},
+		{2020, 53, `"2020-W53"`},
+		{0, 1, `"0000-W01"`},
+	}
+
+	for _, tt := range tests {
+		yw1 := timex.MustNewYearWeek(tt.year, tt.week)
+		bytes, err := yw1.MarshalJSON()
+		assert.NoError(t, err)
+		assert.Equal(t, tt.s, string(bytes))
+
+		var yw2 timex.YearWeek
+		err = yw2.UnmarshalJSON(bytes)
+		assert.NoError(t, err)
+		assert.Equal(t, yw1, yw2)
+
+		text, err := yw1.MarshalText()
+		assert.NoError(t, err)
+		assert.Equal(t, tt.s[1:len(tt.s)-1], string(text))
+
+		var yw3 timex.YearWeek
+		err = yw3.UnmarshalText(text)
+		assert.NoError(t, err)
+		assert.Equal(t, yw1, yw3)
+	}
+
+	t.Run("Null", func(t *testing.T) {
+		var yw timex.YearWeek
+		err := yw.UnmarshalJSON([]byte("null"))
+		assert.NoError(t, err)
+		assert.True(t, yw.IsZero())
+	})
+
+	t.Run("Errors", func(t *testing.T) {
+		_, err := timex.MustNewYearWeek(10000, 1).MarshalJSON()
+		assert.EqualError(t, err, "year is out of range [0,9999]")
+		_, err = timex.MustNewYearWeek(-1, 1).MarshalText()
+		assert.EqualError(t, err, "year is out of range [0,9999]")
+
+		var yw timex.YearWeek
+		err = yw.UnmarshalJSON([]byte("2024-W05"))
+		assert.EqualError(t, err, "YearWeek.UnmarshalJSON: input is not a JSON string")
+	})
+}
+
+func FuzzParseYearWeek(f *testing.F) {
+	f.Add("2024-W05")
+	f.Fuzz(func(t *testing.T, value string) {
+		assert.NotPanics(t, func() {
+			_, _ = timex.ParseYearWeek(value)
+		})
+	})
+}
@@ -0,0 +1,110 @@
+package timex_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/invzhi/timex"
+)
+
+func TestNewYearWeek(t *testing.T) {
+	tests := []struct {
+		year, week int
+		start      timex.Date
+	}{
+		{1, 1, timex.MustNewDate(1, 1, 1)},
+		{2004, 53, timex.MustNewDate(2004, 12, 27)},
+		{2005, 1, timex.MustNewDate(2005, 1, 3)},
+		{2008, 1, timex.MustNewDate(2007, 12, 31)},
+		{2020, 53, timex.MustNewDate(2020, 12, 28)},
+		{2024, 1, timex.MustNewDate(2024, 1, 1)},
+		{2024, 5, timex.MustNewDate(2024, 1, 29)},
+		{2026, 53, timex.MustNewDate(2026, 12, 28)},
+		{0, 1, timex.MustNewDate(0, 1, 3)},
+		{-1, 52, timex.MustNewDate(-1, 12, 27)},
+	}
+
+	for _, tt := range tests {
+		yw := timex.MustNewYearWeek(tt.year, tt.week)
+
+		year, week := yw.YearWeek()
+		assert.Equal(t, tt.year, year)
+		assert.Equal(t, tt.week, week)
+
+		assert.Equal(t, tt.year, yw.Year())
+		assert.Equal(t, tt.week, yw.Week())
+
+		assert.Equal(t, tt.start, yw.Start())
+		assert.Equal(t, tt.start.AddDays(6), yw.End())
+		assert.Equal(t, time.Monday, yw.Start().Weekday())
+		assert.Equal(t, time.Sunday, yw.End().Weekday())
+
+		for n := 0; n < 7; n++ {
+			date := tt.start.AddDays(n)
+			assert.Equal(t, yw, timex.YearWeekOf(date))
+			assert.True(t, yw.Contains(date))
+
+			year, week := date.ISOWeek()
+			assert.Equal(t, tt.year, year)
+			assert.Equal(t, tt.week, week)
+		}
+		assert.False(t, yw.Contains(tt.start.AddDays(-1)))
+		assert.False(t, yw.Contains(tt.start.AddDays(7)))
+	}
+
+	assert.True(t, timex.YearWeek{}.IsZero())
+	assert.Equal(t, timex.MustNewYearWeek(1, 1), timex.YearWeek{})
+
+	t.Run("Errors", func(t *testing.T) {
+		errTests := []struct {
+			year, week int
+			errString  string
+		}{
+			{2024, 0, "week is out of range [1,52]"},
+			{2024, 53, "week is out of range [1,52]"},
+			{2020, 54, "week is out of range [1,53]"},
+		}
+
+		for _, tt := range errTests {
+			_, err := timex.NewYearWeek(tt.year, tt.week)
+			assert.EqualError(t, err, tt.errString)
+
+			assert.Panicsf(t, func() {
+				_ = timex.MustNewYearWeek(tt.year, tt.week)
+			}, "timex: NewYearWeek: "+tt.errString)
+		}
+	})
+}
+
+func TestYearWeekAdd(t *testing.T) {
+	yw := timex.MustNewYearWeek(2020, 52)
+
+	assert.Equal(t, timex.MustNewYearWeek(2020, 53), yw.Add(1))
+	assert.Equal(t, timex.MustNewYearWeek(2021, 1), yw.Add(2))
+	assert.Equal(t, timex.MustNewYearWeek(2020, 1), yw.Add(-51))
+	assert.Equal(t, timex.MustNewYearWeek(2020, 53), yw.Next())
+	assert.Equal(t, timex.MustNewYearWeek(2020, 51), yw.Previous())
+	assert.Equal(t, 2, yw.Add(2).Sub(yw))
+	assert.Equal(t, -51, yw.Add(-51).Sub(yw))
+}
+
+func TestYearWeekBeforeAfter(t *testing.T) {
+	tests := []struct {
+		yw1, yw2      timex.YearWeek
+		before, after bool
+	}{
+		{timex.MustNewYearWeek(2024, 1), timex.MustNewYearWeek(2024, 1), false, false},
+		{timex.MustNewYearWeek(2024, 1), timex.MustNewYearWeek(2024, 2), true, false},
+		{timex.MustNewYearWeek(2020, 53), timex.MustNewYearWeek(2021, 1), true, false},
+		{timex.MustNewYearWeek(2021, 1), timex.MustNewYearWeek(2020, 53), false, true},
+	}
+
+	for _, tt := range tests {
+		assert.Equal(t, tt.before, tt.yw1.Before(tt.yw2))
+		assert.Equal(t, tt.after, tt.yw1.After(tt.yw2))
+		assert.Equal(t, !tt.before && !tt.after, tt.yw1.Equal(tt.yw2))
+		assert.Equal(t, !tt.before && !tt.after, tt.yw2.Equal(tt.yw1))
+	}
+}